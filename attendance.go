@@ -0,0 +1,180 @@
+package recallaigo
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Participant event codes marking when a participant joined and left the call.
+const (
+	participantEventJoin  = "join"
+	participantEventLeave = "leave"
+)
+
+// AttendanceRecord is the attendance of a single meeting participant.
+type AttendanceRecord struct {
+	ParticipantID int
+	Name          string
+	Platform      string
+	// The platform identity of the participant, e.g. the Zoom user GUID or the Slack email.
+	Identity string
+	IsHost   bool
+	// The first time the participant joined the call.
+	JoinedAt time.Time
+	// The last time the participant left the call.
+	LeftAt time.Time
+	// The time spent in the call, summed over every time the participant joined.
+	Duration time.Duration
+}
+
+// attendanceRow is the exported form of an AttendanceRecord, shared by the CSV and JSON writers.
+type attendanceRow struct {
+	ParticipantID   int    `json:"participant_id"`
+	Name            string `json:"name"`
+	Platform        string `json:"platform"`
+	Identity        string `json:"identity"`
+	IsHost          bool   `json:"is_host"`
+	JoinedAt        string `json:"joined_at"`
+	LeftAt          string `json:"left_at"`
+	DurationSeconds int64  `json:"duration_seconds"`
+}
+
+func (r AttendanceRecord) row() attendanceRow {
+	return attendanceRow{
+		ParticipantID:   r.ParticipantID,
+		Name:            r.Name,
+		Platform:        r.Platform,
+		Identity:        r.Identity,
+		IsHost:          r.IsHost,
+		JoinedAt:        formatOptionalTime(r.JoinedAt),
+		LeftAt:          formatOptionalTime(r.LeftAt),
+		DurationSeconds: int64(r.Duration / time.Second),
+	}
+}
+
+// Attendance builds an attendance report from the join and leave events of the participants.
+// Participants still in the call are counted until end; a zero end defaults to the latest event.
+func Attendance(participants []MeetingParticipant, end time.Time) []AttendanceRecord {
+	if end.IsZero() {
+		for _, participant := range participants {
+			for _, event := range participant.Events {
+				if event.CreatedAt.After(end) {
+					end = event.CreatedAt.Time
+				}
+			}
+		}
+	}
+
+	records := make([]AttendanceRecord, 0, len(participants))
+	for _, participant := range participants {
+		record := AttendanceRecord{
+			ParticipantID: participant.ID,
+			Name:          participant.Name,
+			Platform:      participant.Platform,
+			Identity:      participantIdentity(participant),
+			IsHost:        participant.IsHost,
+		}
+
+		type event struct {
+			code string
+			at   time.Time
+		}
+		var events []event
+		for _, e := range participant.Events {
+			if !e.CreatedAt.IsZero() {
+				events = append(events, event{code: e.Code, at: e.CreatedAt.Time})
+			}
+		}
+		slices.SortStableFunc(events, func(a, b event) int {
+			return a.at.Compare(b.at)
+		})
+
+		var joined time.Time
+		for _, e := range events {
+			switch {
+			case e.code == participantEventJoin && joined.IsZero():
+				joined = e.at
+				if record.JoinedAt.IsZero() {
+					record.JoinedAt = e.at
+				}
+			case e.code == participantEventLeave && !joined.IsZero():
+				record.Duration += e.at.Sub(joined)
+				record.LeftAt = e.at
+				joined = time.Time{}
+			}
+		}
+		if !joined.IsZero() && end.After(joined) {
+			record.Duration += end.Sub(joined)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// WriteAttendanceCSV writes the records as CSV with a header row.
+// Times are formatted as RFC 3339 and the duration in whole seconds.
+func WriteAttendanceCSV(w io.Writer, records []AttendanceRecord) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"participant_id", "name", "platform", "identity", "is_host", "joined_at", "left_at", "duration_seconds"})
+	for _, record := range records {
+		row := record.row()
+		cw.Write([]string{
+			strconv.Itoa(row.ParticipantID),
+			row.Name,
+			row.Platform,
+			row.Identity,
+			strconv.FormatBool(row.IsHost),
+			row.JoinedAt,
+			row.LeftAt,
+			strconv.FormatInt(row.DurationSeconds, 10),
+		})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	return nil
+}
+
+// WriteAttendanceJSON writes the records as a JSON array with the same fields as the CSV columns.
+func WriteAttendanceJSON(w io.Writer, records []AttendanceRecord) error {
+	rows := make([]attendanceRow, len(records))
+	for i, record := range records {
+		rows[i] = record.row()
+	}
+	if err := json.NewEncoder(w).Encode(rows); err != nil {
+		return fmt.Errorf("failed to encode attendance: %w", err)
+	}
+	return nil
+}
+
+// participantIdentity returns the platform specific user identifier of the participant.
+func participantIdentity(participant MeetingParticipant) string {
+	extra := participant.ExtraData
+	for _, id := range []string{
+		extra.Zoom.UserGUID,
+		extra.Zoom.ConfUserID,
+		extra.MicrosoftTeams.UserID,
+		extra.Slack.Email,
+		extra.Slack.UserID,
+	} {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,104 @@
+package recallaigo_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestAttendance(t *testing.T) {
+	var participants []recallaigo.MeetingParticipant
+	err := json.Unmarshal([]byte(`[
+		{"id": 1, "name": "Alice", "is_host": true, "platform": "desktop", "extra_data": {"zoom": {"user_guid": "guid-1"}}, "events": [
+			{"code": "join", "created_at": "2025-03-18T10:00:00Z"},
+			{"code": "speech_on", "created_at": "2025-03-18T10:01:00Z"},
+			{"code": "leave", "created_at": "2025-03-18T10:10:00Z"},
+			{"code": "join", "created_at": "2025-03-18T10:20:00Z"},
+			{"code": "leave", "created_at": "2025-03-18T10:30:00Z"}
+		]},
+		{"id": 2, "name": "Bob, Jr.", "platform": "web", "extra_data": {"slack": {"email": "bob@example.com"}}, "events": [
+			{"code": "join", "created_at": "2025-03-18T10:05:00.500Z"}
+		]}
+	]`), &participants)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Attendance", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			end          time.Time
+			wantDuration []time.Duration
+		}{
+			{
+				name:         "counts open sessions until the latest event",
+				wantDuration: []time.Duration{20 * time.Minute, 24*time.Minute + 59500*time.Millisecond},
+			},
+			{
+				name:         "counts open sessions until end",
+				end:          time.Date(2025, 3, 18, 11, 5, 0, 500000000, time.UTC),
+				wantDuration: []time.Duration{20 * time.Minute, time.Hour},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				records := recallaigo.Attendance(participants, tt.end)
+				for i, record := range records {
+					if record.Duration != tt.wantDuration[i] {
+						t.Errorf("Attendance()[%d].Duration = %v, want %v", i, record.Duration, tt.wantDuration[i])
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("WriteAttendanceCSV", func(t *testing.T) {
+		var b strings.Builder
+		if err := recallaigo.WriteAttendanceCSV(&b, recallaigo.Attendance(participants, time.Time{})); err != nil {
+			t.Fatalf("WriteAttendanceCSV() error = %v", err)
+		}
+
+		want := `participant_id,name,platform,identity,is_host,joined_at,left_at,duration_seconds
+1,Alice,desktop,guid-1,true,2025-03-18T10:00:00Z,2025-03-18T10:30:00Z,1200
+2,"Bob, Jr.",web,bob@example.com,false,2025-03-18T10:05:00Z,,1499
+`
+		if b.String() != want {
+			t.Errorf("WriteAttendanceCSV() = %s, want %s", b.String(), want)
+		}
+	})
+
+	t.Run("WriteAttendanceJSON", func(t *testing.T) {
+		var b strings.Builder
+		if err := recallaigo.WriteAttendanceJSON(&b, recallaigo.Attendance(participants[:1], time.Time{})); err != nil {
+			t.Fatalf("WriteAttendanceJSON() error = %v", err)
+		}
+
+		want := `[{"participant_id":1,"name":"Alice","platform":"desktop","identity":"guid-1","is_host":true,"joined_at":"2025-03-18T10:00:00Z","left_at":"2025-03-18T10:30:00Z","duration_seconds":1200}]
+`
+		if b.String() != want {
+			t.Errorf("WriteAttendanceJSON() = %s, want %s", b.String(), want)
+		}
+	})
+}
+
+func TestAttendanceFromParticipantEvents(t *testing.T) {
+	joined := time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC)
+	participants := []recallaigo.MeetingParticipant{{
+		ID:   1,
+		Name: "Alice",
+		Events: []recallaigo.ParticipantEvent{
+			{Code: "join", CreatedAt: recallaigo.Time{Time: joined}},
+			{Code: "leave", CreatedAt: recallaigo.Time{Time: joined.Add(time.Minute)}},
+		},
+		ExtraData: recallaigo.ExtraData{MicrosoftTeams: recallaigo.MicrosoftTeamsData{UserID: "teams-user"}},
+	}}
+
+	records := recallaigo.Attendance(participants, time.Time{})
+	if records[0].Duration != time.Minute || records[0].Identity != "teams-user" {
+		t.Errorf("Attendance() = %+v, want one minute for teams-user", records[0])
+	}
+}
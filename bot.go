@@ -4,23 +4,38 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"reflect"
+	"slices"
+	"time"
 )
 
 type BotService interface {
 	ListBots(ctx context.Context, params *ListBotsParams) (*ListBotResponse, error)
 	CreateBot(ctx context.Context, request *CreateBotRequest) (*Bot, error)
+	CreateBots(ctx context.Context, requests []CreateBotRequest, opts ...CreateBotsOptions) (*CreateBotsReport, error)
 	ListChatMessages(ctx context.Context, botID string, params ...ListChatMessagesParams) (*ListMessagesResponse, error)
 	RetrieveBot(ctx context.Context, botID string) (*Bot, error)
+	RetrieveBots(ctx context.Context, ids []string, concurrency int) []RetrieveBotResult
 	UpdateScheduledBot(ctx context.Context, botID string, request *CreateBotRequest) (*Bot, error)
 	DeleteScheduledBot(ctx context.Context, botID string) error
+	UpsertScheduledBot(ctx context.Context, botID string, request *CreateBotRequest) (*Bot, error)
+	ImportScheduledBot(ctx context.Context, botID string) (*Bot, error)
 	DeleteBotMedia(ctx context.Context, botID string) error
+	RemoveBotFromCall(ctx context.Context, botID string) (*Bot, error)
+	GetBotIntelligence(ctx context.Context, botID string) (*IntelligenceResult, error)
 	GetBotLogs(ctx context.Context, botID string) (*LogEntry, error)
 	OutputAudio(ctx context.Context, botID string, request *OutputAudioRequest) (*Bot, error)
+	OutputAudioFromFile(ctx context.Context, botID string, name string, opts ...OutputAudioOptions) (*Bot, error)
+	OutputAudioFromReader(ctx context.Context, botID string, r io.Reader, opts ...OutputAudioOptions) (*Bot, error)
 	StopOutputAudio(ctx context.Context, botID string) error
 	OutputMedia(ctx context.Context, botID string, request *OutputMedia) (*Bot, error)
 	StopOutputMedia(ctx context.Context, botID string) error
 	StartScreenshare(ctx context.Context, botID string, request *OutputVideoRequest) (*Bot, error)
+	StartScreenshareFromFile(ctx context.Context, botID string, name string) (*Bot, error)
+	StartScreenshareFromReader(ctx context.Context, botID string, r io.Reader) (*Bot, error)
 	StopScreenshare(ctx context.Context, botID string) error
 	OutputVideo(ctx context.Context, botID string, request *OutputVideoRequest) (*Bot, error)
 	StopOutputVideo(ctx context.Context, botID string) error
@@ -32,7 +47,14 @@ type BotService interface {
 	StartRecording(ctx context.Context, botID string, request *StartRecordingRequest) (*Bot, error)
 	StopRecording(ctx context.Context, botID string) (*Bot, error)
 	GetBotTranscript(ctx context.Context, botID string, params ...GetBotTranscriptParams) ([]TranscriptEntry, error)
-	AnalyzeBotMedia(ctx context.Context, botId string, request *AnalyzeBotMediaRequest) (*AnalyzeBotMediaResponse, error)
+	StreamBotTranscript(ctx context.Context, botID string, fn func(TranscriptEntry) error, params ...GetBotTranscriptParams) error
+	AnalyzeBotMedia(ctx context.Context, botId string, request *AnalyzeBotMediaRequest, opts ...AnalyzeBotMediaOptions) (*AnalyzeBotMediaResponse, error)
+	ListBotScreenshots(ctx context.Context, botID string, params ...ListBotScreenshotsParams) (*ListScreenshotsResponse, error)
+	WaitForStatus(ctx context.Context, botID string, interval time.Duration, statuses ...Status) (*Bot, error)
+	PollTranscript(ctx context.Context, botID string, interval time.Duration) <-chan TranscriptUpdate
+	ExportPersonalData(ctx context.Context, botID string, matches SubjectMatcher) (*PersonalDataExport, error)
+	TranscribeAndWait(ctx context.Context, botID string, request *AnalyzeBotMediaRequest, opts ...TranscribeAndWaitOptions) ([]TranscriptEntry, error)
+	RequestRecordingPermissionAndWait(ctx context.Context, botID string, opts ...RecordingPermissionOptions) (*RecordingPermissionResult, error)
 }
 
 type BotClient struct {
@@ -94,6 +116,9 @@ type ListBotsParams struct {
 	Platform []Platform `json:"platform,omitempty"`
 	// Filter bots by status(es)
 	Status []Status `json:"status,omitempty"`
+	// Filter bots by metadata key-value pairs, sent as metadata__<key> parameters.
+	// ListBotsByMetadata also filters them client-side.
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // ListBotResponse represents the response body for the List method
@@ -116,9 +141,9 @@ type Bot struct {
 	// The time at which the bot will join the call, formatted in ISO 8601.
 	// This field can only be read from scheduled bots that have not yet joined a call.
 	// Once a bot has joined a call, its join_at will be cleared.
-	JoinAt              *string              `json:"join_at,omitempty"`
+	JoinAt              *Time                `json:"join_at,omitempty"`
 	VideoURL            string               `json:"video_url"`
-	MediaRetentionEnd   string               `json:"media_retention_end"`
+	MediaRetentionEnd   Time                 `json:"media_retention_end"`
 	StatusChanges       []StatusChange       `json:"status_changes"`
 	MeetingMetadata     MeetingMetadata      `json:"meeting_metadata"`
 	MeetingParticipants []MeetingParticipant `json:"meeting_participants"`
@@ -146,6 +171,8 @@ type Bot struct {
 	Chat *Chat `json:"chat,omitempty"`
 	// (BETA) Settings for the bot to automatically leave the meeting.
 	AutomaticLeave *AutomaticLeave `json:"automatic_leave,omitempty"`
+	// Settings for which breakout room the bot joins. This is applicable for Zoom only.
+	BreakoutRoom *BreakoutRoom `json:"breakout_room,omitempty"`
 	// Configure bot variants per meeting platforms, e.g. {"zoom": "web_4_core"}.
 	Variant          *Variant          `json:"variants,omitempty"`
 	CalendarMeetings []CalendarMeeting `json:"calendar_meetings"`
@@ -157,16 +184,34 @@ type Bot struct {
 	SlackAuthenticator *SlackAuthenticator `json:"slack_authenticator,omitempty"`
 	// Slack Huddle Observer specific parameters
 	SlackHuddleObserver *SlackHuddleObserver `json:"slack_huddle_observer,omitempty"`
+	// The huddles detected by a Slack huddle observer, with whether it joined them.
+	ObservedHuddles []ObservedHuddle `json:"observed_huddles,omitempty"`
 	// Metadata for the bot, which can include additional information as key-value pairs.
-	Metadata  map[string]string `json:"metadata,omitempty"`
-	Recording string            `json:"recording"`
+	Metadata Metadata `json:"metadata,omitempty"`
+	// The ID of the current recording of the bot. The recordings themselves are in Recordings.
+	RecordingID string `json:"recording"`
 }
 
-type MeetingURL struct {
-	MeetingID       string  `json:"meeting_id,omitempty"`
-	MeetingPassword string  `json:"meeting_password,omitempty"`
-	TK              *string `json:"tk,omitempty"`
-	Platform        string  `json:"platform,omitempty"`
+// LatestRecording returns the most recently created recording of the bot, or nil if there is none.
+func (b *Bot) LatestRecording() *Recording {
+	var latest *Recording
+	for i := range b.Recordings {
+		if latest == nil || b.Recordings[i].CreatedAt.After(latest.CreatedAt.Time) {
+			latest = &b.Recordings[i]
+		}
+	}
+	return latest
+}
+
+// currentRecordingID returns the ID of the current recording, falling back to the latest recording.
+func (b *Bot) currentRecordingID() string {
+	if b.RecordingID != "" {
+		return b.RecordingID
+	}
+	if latest := b.LatestRecording(); latest != nil {
+		return latest.ID
+	}
+	return ""
 }
 
 type RecordingMode string
@@ -186,40 +231,25 @@ type MeetingMetadata struct {
 }
 
 type MeetingParticipant struct {
-	ID     int    `json:"id"`
-	Name   string `json:"name"`
-	Events []struct {
-		Code      string `json:"code"`
-		CreatedAt string `json:"created_at"`
-	} `json:"events"`
-	IsHost    bool   `json:"is_host"`
-	Platform  string `json:"platform"`
-	ExtraData struct {
-		Zoom struct {
-			UserGUID   string `json:"user_guid"`
-			Guest      bool   `json:"guest"`
-			ConfUserID string `json:"conf_user_id"`
-		} `json:"zoom"`
-		MicrosoftTeams struct {
-			ParticipantType string `json:"participant_type"`
-			Role            string `json:"role"`
-			MeetingRole     string `json:"meeting_role"`
-			UserID          string `json:"user_id"`
-			TenantID        string `json:"tenant_id"`
-			ClientVersion   string `json:"client_version"`
-		} `json:"microsoft_teams"`
-		Slack struct {
-			UserID string `json:"user_id"`
-			Email  string `json:"email"`
-		} `json:"slack"`
-	} `json:"extra_data"`
+	ID        int                `json:"id"`
+	Name      string             `json:"name"`
+	Events    []ParticipantEvent `json:"events"`
+	IsHost    bool               `json:"is_host"`
+	Platform  string             `json:"platform"`
+	ExtraData ExtraData          `json:"extra_data"`
 }
 
-type StatusChange struct {
+// ParticipantEvent is an event of a meeting participant, such as "join" or "leave".
+type ParticipantEvent struct {
 	Code      string `json:"code"`
-	Message   string `json:"message"`
-	CreatedAt string `json:"created_at"`
-	SubCode   string `json:"sub_code"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+type StatusChange struct {
+	Code      Status  `json:"code"`
+	Message   string  `json:"message"`
+	CreatedAt Time    `json:"created_at"`
+	SubCode   SubCode `json:"sub_code"`
 }
 
 type TranscriptionProvider string
@@ -247,6 +277,15 @@ type TranscriptionOptions struct {
 	GladiaV2                        *GladiaV2             `json:"gladia_v2,omitempty"`
 	Rev                             *Rev                  `json:"rev,omitempty"`
 	Speechmatics                    *Speechmatics         `json:"speechmatics,omitempty"`
+	MeetingCaptions                 *MeetingCaptions      `json:"meeting_captions,omitempty"`
+}
+
+// MeetingCaptions configures transcription with the captions of the meeting platform,
+// for TranscriptionProviderMeetingCaptions. Captions must be available in the meeting,
+// e.g. enabled by the host on Zoom.
+type MeetingCaptions struct {
+	// The spoken language the platform captions, e.g. "en-US". Defaults to the language of the meeting.
+	Language string `json:"language,omitempty"`
 }
 
 type AssemblyAI struct {
@@ -289,27 +328,31 @@ type Gladia struct {
 }
 
 type GladiaV2 struct {
-	Model                             string   `json:"model,omitempty"`
-	Endpointing                       float64  `json:"endpointing"`
-	MaximumDurationWithoutEndpointing float64  `json:"maximum_duration_without_endpointing"`
-	Languages                         []string `json:"languages"`
-	CodeSwitching                     bool     `json:"code_switching"`
-	AudioEnhancer                     bool     `json:"audio_enhancer"`
-	SpeechThreshold                   float64  `json:"speech_threshold"`
-	CustomVocabulary                  bool     `json:"custom_vocabulary"`
-	CustomVocabularyConfig            struct {
-		DefaultIntensity float64 `json:"default_intensity"`
-		Vocabulary       []struct {
-			Value          string   `json:"value"`
-			Intensity      float64  `json:"intensity"`
-			Pronunciations []string `json:"pronunciations"`
-			Language       string   `json:"language"`
-		} `json:"vocabulary"`
-		SentimentAnalysis  bool    `json:"sentiment_analysis"`
-		SentimentIntensity float64 `json:"sentiment_intensity"`
-		SentimentLabel     string  `json:"sentiment_label"`
-	} `json:"custom_vocabulary_config"`
-	Region string `json:"region"`
+	Model                             string                         `json:"model,omitempty"`
+	Endpointing                       float64                        `json:"endpointing"`
+	MaximumDurationWithoutEndpointing float64                        `json:"maximum_duration_without_endpointing"`
+	Languages                         []string                       `json:"languages"`
+	CodeSwitching                     bool                           `json:"code_switching"`
+	AudioEnhancer                     bool                           `json:"audio_enhancer"`
+	SpeechThreshold                   float64                        `json:"speech_threshold"`
+	CustomVocabulary                  bool                           `json:"custom_vocabulary"`
+	CustomVocabularyConfig            GladiaV2CustomVocabularyConfig `json:"custom_vocabulary_config"`
+	Region                            string                         `json:"region"`
+}
+
+type GladiaV2CustomVocabularyConfig struct {
+	DefaultIntensity   float64              `json:"default_intensity"`
+	Vocabulary         []GladiaV2Vocabulary `json:"vocabulary"`
+	SentimentAnalysis  bool                 `json:"sentiment_analysis"`
+	SentimentIntensity float64              `json:"sentiment_intensity"`
+	SentimentLabel     string               `json:"sentiment_label"`
+}
+
+type GladiaV2Vocabulary struct {
+	Value          string   `json:"value"`
+	Intensity      float64  `json:"intensity"`
+	Pronunciations []string `json:"pronunciations"`
+	Language       string   `json:"language"`
 }
 
 type Rev struct {
@@ -329,25 +372,31 @@ type Rev struct {
 }
 
 type Speechmatics struct {
-	Language        string `json:"language,omitempty"`
-	AdditionalVocab []struct {
-		Content    string   `json:"content,omitempty"`
-		SoundsLike []string `json:"sounds_like"`
-	} `json:"additional_vocab"`
-	Diarization              string `json:"diarization,omitempty"`
-	SpeakerDiarizationConfig struct {
-		MaxSpeakers int `json:"max_speakers"`
-	} `json:"speaker_diarization_config"`
-	EnablePartials       bool    `json:"enable_partials"`
-	MaxDelay             float64 `json:"max_delay"`
-	MaxDelayMode         string  `json:"max_delay_mode,omitempty"`
-	OutputLocale         string  `json:"output_locale,omitempty"`
-	PunctuationOverrides struct {
-		PermittedMarks []string `json:"permitted_marks"`
-		Sensitivity    float64  `json:"sensitivity"`
-	} `json:"punctuation_overrides"`
-	OperatingPoint string `json:"operating_point,omitempty"`
-	EnableEntities bool   `json:"enable_entities"`
+	Language                 string                               `json:"language,omitempty"`
+	AdditionalVocab          []SpeechmaticsVocab                  `json:"additional_vocab"`
+	Diarization              string                               `json:"diarization,omitempty"`
+	SpeakerDiarizationConfig SpeechmaticsSpeakerDiarizationConfig `json:"speaker_diarization_config"`
+	EnablePartials           bool                                 `json:"enable_partials"`
+	MaxDelay                 float64                              `json:"max_delay"`
+	MaxDelayMode             string                               `json:"max_delay_mode,omitempty"`
+	OutputLocale             string                               `json:"output_locale,omitempty"`
+	PunctuationOverrides     SpeechmaticsPunctuationOverrides     `json:"punctuation_overrides"`
+	OperatingPoint           string                               `json:"operating_point,omitempty"`
+	EnableEntities           bool                                 `json:"enable_entities"`
+}
+
+type SpeechmaticsVocab struct {
+	Content    string   `json:"content,omitempty"`
+	SoundsLike []string `json:"sounds_like"`
+}
+
+type SpeechmaticsSpeakerDiarizationConfig struct {
+	MaxSpeakers int `json:"max_speakers"`
+}
+
+type SpeechmaticsPunctuationOverrides struct {
+	PermittedMarks []string `json:"permitted_marks"`
+	Sensitivity    float64  `json:"sensitivity"`
 }
 
 type AWSTranscribe struct {
@@ -407,11 +456,28 @@ type ChatOnParticipantJoin struct {
 	ExcludeHost bool   `json:"exclude_host"`
 }
 
+// Recording is a recording made by a bot. A bot makes a new recording each time it starts recording.
 type Recording struct {
 	ID          string `json:"id,omitempty"`
-	CreatedAt   string `json:"created_at,omitempty"`
-	StartedAt   string `json:"started_at,omitempty"`
-	CompletedAt string `json:"completed_at,omitempty"`
+	CreatedAt   Time   `json:"created_at"`
+	StartedAt   Time   `json:"started_at"`
+	CompletedAt Time   `json:"completed_at"`
+	// The time after which the media of the recording is deleted.
+	ExpiresAt Time `json:"expires_at"`
+	// The processing status of the recording, e.g. "processing", "done" or "failed".
+	Status *ArtifactStatus `json:"status,omitempty"`
+	// The artifacts of the recording, when they were requested in the recording config.
+	MediaShortcuts *MediaShortcuts `json:"media_shortcuts,omitempty"`
+	Metadata       Metadata        `json:"metadata,omitempty"`
+}
+
+// MediaShortcuts are the artifacts of a recording.
+type MediaShortcuts struct {
+	VideoMixed        *MediaArtifact `json:"video_mixed,omitempty"`
+	AudioMixed        *MediaArtifact `json:"audio_mixed,omitempty"`
+	Transcript        *MediaArtifact `json:"transcript,omitempty"`
+	ParticipantEvents *MediaArtifact `json:"participant_events,omitempty"`
+	MeetingMetadata   *MediaArtifact `json:"meeting_metadata,omitempty"`
 }
 
 type OutputMedia struct {
@@ -456,13 +522,15 @@ type ReplayOnParticipantJoin struct {
 	DisableAfter     int    `json:"disable_after"`
 }
 
+// AutomaticLeave configures when the bot leaves the call on its own.
+// Timeouts are sent to the API in whole seconds; unset optional timeouts use the defaults of the API.
 type AutomaticLeave struct {
-	WaitingRoomTimeout               int              `json:"waiting_room_timeout,omitempty"`
-	NooneJoinedTimeout               int              `json:"noone_joined_timeout,omitempty"`
-	EveryoneLeftTimeout              int              `json:"everyone_left_timeout"`
-	InCallNotRecordingTimeout        int              `json:"in_call_not_recording_timeout,omitempty"`
-	InCallRecordingTimeout           int              `json:"in_call_recording_timeout,omitempty"`
-	RecordingPermissionDeniedTimeout int              `json:"recording_permission_denied_timeout,omitempty"`
+	WaitingRoomTimeout               *Duration        `json:"waiting_room_timeout,omitempty"`
+	NooneJoinedTimeout               *Duration        `json:"noone_joined_timeout,omitempty"`
+	EveryoneLeftTimeout              Duration         `json:"everyone_left_timeout"`
+	InCallNotRecordingTimeout        *Duration        `json:"in_call_not_recording_timeout,omitempty"`
+	InCallRecordingTimeout           *Duration        `json:"in_call_recording_timeout,omitempty"`
+	RecordingPermissionDeniedTimeout *Duration        `json:"recording_permission_denied_timeout,omitempty"`
 	SilenceDetection                 SilenceDetection `json:"silence_detection"`
 	BotDetection                     BotDetection     `json:"bot_detection"`
 }
@@ -470,13 +538,13 @@ type AutomaticLeave struct {
 // EveryoneLeftTimeout represents the timeout configuration for when all participants leave the call.
 // This struct is currently deprecated and not used in the current API version.
 type EveryoneLeftTimeout struct {
-	Timeout       int  `json:"timeout,omitempty"`
-	ActivateAfter *int `json:"activate_after,omitempty"`
+	Timeout       *Duration `json:"timeout,omitempty"`
+	ActivateAfter *Duration `json:"activate_after,omitempty"`
 }
 
 type SilenceDetection struct {
-	Timeout       int `json:"timeout,omitempty"`
-	ActivateAfter int `json:"activate_after,omitempty"`
+	Timeout       *Duration `json:"timeout,omitempty"`
+	ActivateAfter *Duration `json:"activate_after,omitempty"`
 }
 
 type BotDetection struct {
@@ -485,20 +553,60 @@ type BotDetection struct {
 }
 
 type UsingParticipantEvents struct {
-	Timeout       int `json:"timeout,omitempty"`
-	ActivateAfter int `json:"activate_after,omitempty"`
+	Timeout       *Duration `json:"timeout,omitempty"`
+	ActivateAfter *Duration `json:"activate_after,omitempty"`
 }
 
 type UsingParticipantNames struct {
-	Timeout       int      `json:"timeout"`
-	ActivateAfter int      `json:"activate_after"`
+	Timeout       Duration `json:"timeout"`
+	ActivateAfter Duration `json:"activate_after"`
 	Matches       []string `json:"matches"`
 }
 
 type Variant struct {
-	Zoom           VariantOption `json:"zoom"`
-	GoogleMeet     VariantOption `json:"google_meet"`
-	MicrosoftTeams VariantOption `json:"microsoft_teams"`
+	Zoom               VariantOption `json:"zoom,omitempty"`
+	GoogleMeet         VariantOption `json:"google_meet,omitempty"`
+	MicrosoftTeams     VariantOption `json:"microsoft_teams,omitempty"`
+	MicrosoftTeamsLive VariantOption `json:"microsoft_teams_live,omitempty"`
+}
+
+// For returns the variant of the platform of the meeting URL, as detected by ParseMeetingURL,
+// or nil if v has none for it. With v as the defaults of all platforms, the variant of Microsoft
+// Teams meetings is set for microsoft_teams or microsoft_teams_live according to the URL:
+//
+//	request.Variant = defaults.For(request.MeetingURL)
+func (v Variant) For(meetingURL string) *Variant {
+	var variant Variant
+	switch Platform(ParseMeetingURL(meetingURL).Platform) {
+	case PlatformZoom:
+		variant.Zoom = v.Zoom
+	case PlatformGoogleMeet:
+		variant.GoogleMeet = v.GoogleMeet
+	case PlatformMicrosoftTeams:
+		variant.MicrosoftTeams = v.MicrosoftTeams
+	case PlatformMicrosoftTeamsLive:
+		variant.MicrosoftTeamsLive = v.MicrosoftTeamsLive
+	}
+
+	if variant == (Variant{}) {
+		return nil
+	}
+	return &variant
+}
+
+// forPlatform returns the variant of the platform, or an empty one if v has none for it.
+func (v Variant) forPlatform(platform Platform) VariantOption {
+	switch platform {
+	case PlatformZoom:
+		return v.Zoom
+	case PlatformGoogleMeet:
+		return v.GoogleMeet
+	case PlatformMicrosoftTeams:
+		return v.MicrosoftTeams
+	case PlatformMicrosoftTeamsLive:
+		return v.MicrosoftTeamsLive
+	}
+	return ""
 }
 
 type VariantOption string
@@ -511,8 +619,8 @@ const (
 
 type CalendarMeeting struct {
 	ID           string       `json:"id"`
-	StartTime    string       `json:"start_time"`
-	EndTime      string       `json:"end_time"`
+	StartTime    Time         `json:"start_time"`
+	EndTime      Time         `json:"end_time"`
 	CalendarUser CalendarUser `json:"calendar_user"`
 }
 
@@ -527,9 +635,81 @@ type Zoom struct {
 	UserEmail    string `json:"user_email"`
 }
 
+// GoogleMeetLoginMode decides when an authenticated Google Meet bot signs in.
+type GoogleMeetLoginMode string
+
+const (
+	// The bot always signs in with a login of its group.
+	GoogleMeetLoginModeAlways GoogleMeetLoginMode = "always"
+	// The bot only signs in when the meeting does not admit anonymous participants.
+	GoogleMeetLoginModeOnlyIfRequired GoogleMeetLoginMode = "only_if_required"
+)
+
+// BreakoutRoomMode decides which breakout room a bot joins.
+type BreakoutRoomMode string
+
+const (
+	// The bot stays in the main room, declining invites to breakout rooms.
+	BreakoutRoomJoinMainRoom BreakoutRoomMode = "join_main_room"
+	// The bot joins the breakout room with the name of BreakoutRoom.RoomName.
+	BreakoutRoomJoinSpecificRoom BreakoutRoomMode = "join_specific_room"
+	// The bot accepts every invite to a breakout room, following the host moving it between rooms.
+	BreakoutRoomAutoAcceptAllInvites BreakoutRoomMode = "auto_accept_all_invites"
+)
+
+// BreakoutRoom configures the breakout room a bot joins.
+type BreakoutRoom struct {
+	Mode BreakoutRoomMode `json:"mode"`
+	// The name of the room to join with BreakoutRoomJoinSpecificRoom.
+	RoomName string `json:"room_name,omitempty"`
+}
+
+// Validate checks that the mode is known and has the room it needs.
+func (b *BreakoutRoom) Validate() error {
+	switch b.Mode {
+	case BreakoutRoomJoinMainRoom, BreakoutRoomAutoAcceptAllInvites:
+	case BreakoutRoomJoinSpecificRoom:
+		if b.RoomName == "" {
+			return fmt.Errorf("a room name is required to join a specific breakout room")
+		}
+	default:
+		return fmt.Errorf("invalid breakout room mode %q", b.Mode)
+	}
+	return nil
+}
+
+// GoogleMeet configures authenticated Google Meet bots, which sign in with a Google login of a login group.
 type GoogleMeet struct {
-	LoginRequired      bool   `json:"login_required"`
+	// Whether the bot must sign in. Requires GoogleLoginGroupID.
+	LoginRequired bool `json:"login_required"`
+	// The login group the login of the bot is chosen from.
 	GoogleLoginGroupID string `json:"google_login_group_id"`
+	// When the bot signs in. Defaults to the login mode of the group.
+	LoginMode GoogleMeetLoginMode `json:"login_mode,omitempty"`
+	// A specific login of the group to sign in with, instead of any available one.
+	GoogleLoginID string `json:"google_login_id,omitempty"`
+}
+
+// Validate checks that the login group is set for the options signing in, so that the bot does
+// not silently join unauthenticated.
+func (g *GoogleMeet) Validate() error {
+	switch g.LoginMode {
+	case "", GoogleMeetLoginModeAlways, GoogleMeetLoginModeOnlyIfRequired:
+	default:
+		return fmt.Errorf("invalid Google Meet login mode %q", g.LoginMode)
+	}
+
+	if g.GoogleLoginGroupID == "" {
+		switch {
+		case g.LoginRequired:
+			return fmt.Errorf("a Google login group is required when login is required")
+		case g.LoginMode != "":
+			return fmt.Errorf("a Google login group is required with a login mode")
+		case g.GoogleLoginID != "":
+			return fmt.Errorf("a Google login group is required with a Google login")
+		}
+	}
+	return nil
 }
 
 type SlackAuthenticator struct {
@@ -551,9 +731,34 @@ type SlackHuddleObserver struct {
 	HuddleBotAPIToken         string   `json:"huddle_bot_api_token,omitempty"`
 }
 
+// HuddleJoinDecision is what a Slack huddle observer decided for a huddle it detected.
+type HuddleJoinDecision string
+
+const (
+	// The observer joined the public huddle.
+	HuddleJoined HuddleJoinDecision = "joined"
+	// The observer asked the participants of the private huddle to let it join.
+	HuddleAskedToJoin HuddleJoinDecision = "asked_to_join"
+	// The participants of the private huddle declined the request to join.
+	HuddleJoinDeclined HuddleJoinDecision = "declined"
+	// The observer ignored the huddle, e.g. because none of its participants is in FilterHuddlesByUserEmails.
+	HuddleSkipped HuddleJoinDecision = "skipped"
+)
+
+// ObservedHuddle is a huddle detected by a Slack huddle observer.
+type ObservedHuddle struct {
+	SlackHuddleID  string             `json:"slack_huddle_id"`
+	SlackChannelID string             `json:"slack_channel_id"`
+	IsPrivate      bool               `json:"is_private"`
+	DetectedAt     Time               `json:"detected_at"`
+	JoinDecision   HuddleJoinDecision `json:"join_decision"`
+	// The bot recording the huddle, empty unless the observer joined it.
+	BotID string `json:"bot_id,omitempty"`
+}
+
 type Message struct {
 	Text      string `json:"text,omitempty"`
-	CreatedAt string `json:"created_at,omitempty"`
+	CreatedAt Time   `json:"created_at"`
 	To        string `json:"to,omitempty"`
 	Sender    Sender `json:"sender"`
 }
@@ -595,9 +800,7 @@ type SlackData struct {
 // Get a list of all bots
 // see https://docs.recall.ai/reference/bot_list
 func (c *BotClient) ListBots(ctx context.Context, params *ListBotsParams) (*ListBotResponse, error) {
-	queryParams := buildQueryParams(params)
-
-	res, err := c.client.request(ctx, http.MethodGet, "bot", queryParams, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodGet, "bot", buildQueryParams(params), nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list bots: %w", err)
 	}
@@ -610,49 +813,36 @@ func (c *BotClient) ListBots(ctx context.Context, params *ListBotsParams) (*List
 	// fmt.Println(string(bodyBytes))
 
 	var response ListBotResponse
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	for i := range response.Results {
+		c.client.remember(&response.Results[i])
+	}
 
 	return &response, nil
 }
 
-func buildQueryParams(params *ListBotsParams) map[string][]string {
-	queryParams := make(map[string][]string)
-
+func buildQueryParams(params *ListBotsParams) *query {
 	if params == nil {
-		return queryParams
+		return nil
 	}
 
-	addQueryParam := func(key, value string) {
-		if value != "" {
-			queryParams[key] = []string{value}
-		}
+	q := &query{}
+	q.addString("join_at_after", params.JoinAtAfter)
+	q.addString("join_at_before", params.JoinAtBefore)
+	q.addString("meeting_url", params.MeetingURL)
+	q.addInt("page", params.Page)
+	for _, platform := range params.Platform {
+		q.add("platform", string(platform))
 	}
-
-	addQueryParam("join_at_after", params.JoinAtAfter)
-	addQueryParam("join_at_before", params.JoinAtBefore)
-	addQueryParam("meeting_url", params.MeetingURL)
-
-	if params.Page != 0 {
-		queryParams["page"] = []string{fmt.Sprintf("%d", params.Page)}
+	for _, status := range params.Status {
+		q.add("status", string(status))
 	}
-	if len(params.Platform) > 0 {
-		queryParams["platform"] = convertToStringSlice(params.Platform)
+	for _, key := range slices.Sorted(maps.Keys(params.Metadata)) {
+		q.add("metadata__"+key, params.Metadata[key])
 	}
-	if len(params.Status) > 0 {
-		queryParams["status"] = convertToStringSlice(params.Status)
-	}
-
-	return queryParams
-}
-
-func convertToStringSlice[T fmt.Stringer](items []T) []string {
-	result := make([]string, len(items))
-	for i, item := range items {
-		result[i] = item.String()
-	}
-	return result
+	return q
 }
 
 // CreateBotRequest represents the request body for the CreateBot method
@@ -666,7 +856,8 @@ type CreateBotRequest struct {
 	// The time at which the bot will join the call, formatted in ISO 8601.
 	// This field can only be read from scheduled bots that have not yet joined a call.
 	// Once a bot has joined a call, its join_at will be cleared.
-	JoinAt *string `json:"join_at,omitempty"`
+	// Use ScheduleIn or ScheduleAt to set it.
+	JoinAt *time.Time `json:"join_at,omitempty"`
 	// The settings for real-time transcription.
 	RealTimeTranscription *RealTimeTranscription `json:"real_time_transcription,omitempty"`
 	// The settings for real-time media output.
@@ -691,6 +882,8 @@ type CreateBotRequest struct {
 	Chat *Chat `json:"chat,omitempty"`
 	// (BETA) Settings for the bot to automatically leave the meeting.
 	AutomaticLeave *AutomaticLeave `json:"automatic_leave,omitempty"`
+	// Settings for which breakout room the bot joins. This is applicable for Zoom only.
+	BreakoutRoom *BreakoutRoom `json:"breakout_room,omitempty"`
 	// Configure bot variants per meeting platforms, e.g. {"zoom": "web_4_core"}.
 	Variant *Variant `json:"variants,omitempty"`
 	// Zoom specific parameters
@@ -702,7 +895,7 @@ type CreateBotRequest struct {
 	// Slack Huddle Observer specific parameters
 	SlackHuddleObserver *SlackHuddleObserver `json:"slack_huddle_observer,omitempty"`
 	// Metadata for the bot, which can include additional information as key-value pairs.
-	Metadata map[string]string `json:"metadata,omitempty"`
+	Metadata Metadata `json:"metadata,omitempty"`
 }
 
 func (r *CreateBotRequest) Validate() error {
@@ -712,27 +905,54 @@ func (r *CreateBotRequest) Validate() error {
 	if r.BotName == "" {
 		return fmt.Errorf("bot name is required")
 	}
+	if r.GoogleMeet != nil {
+		if err := r.GoogleMeet.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.BreakoutRoom != nil {
+		if err := r.BreakoutRoom.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// ScheduleIn returns the time d from now in UTC, for the JoinAt field of a CreateBotRequest.
+func ScheduleIn(d time.Duration) *time.Time {
+	return ScheduleAt(time.Now().Add(d))
+}
+
+// ScheduleAt returns t in UTC, for the JoinAt field of a CreateBotRequest.
+func ScheduleAt(t time.Time) *time.Time {
+	t = t.UTC()
+	return &t
+}
+
 // CreateBot a new bot
 // see https://docs.recall.ai/reference/bot_create
 func (c *BotClient) CreateBot(ctx context.Context, request *CreateBotRequest) (*Bot, error) {
 	if err := request.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid request: %w", err)
 	}
+	request, err := c.client.withZoomTokens(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	request = c.client.withDefaultVariants(request)
 
-	res, err := c.client.request(ctx, http.MethodPost, "bot", nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, "bot", nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create bot: %w", err)
 	}
 	defer res.Body.Close()
 
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	c.client.remember(&response)
 
 	return &response, nil
 }
@@ -755,19 +975,14 @@ func (c *BotClient) ListChatMessages(ctx context.Context, botID string, params .
 	path := fmt.Sprintf("bot/%s/chat-messages", botID)
 
 	// Prepare query parameters
-	queryParams := make(map[string][]string)
+	q := &query{}
 	if len(params) > 0 {
-		param := params[0]
-		if param.Cursor != "" {
-			queryParams["cursor"] = []string{param.Cursor}
-		}
-		if param.Ordering != "" {
-			queryParams["ordering"] = []string{param.Ordering}
-		}
+		q.addString("cursor", params[0].Cursor)
+		q.addString("ordering", params[0].Ordering)
 	}
 
 	// Make the request
-	res, err := c.client.request(ctx, http.MethodGet, path, queryParams, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list chat messages: %w", err)
 	}
@@ -775,7 +990,7 @@ func (c *BotClient) ListChatMessages(ctx context.Context, botID string, params .
 
 	// Decode the response
 	var message ListMessagesResponse
-	if err := json.NewDecoder(res.Body).Decode(&message); err != nil {
+	if err := c.client.decode(res, &message); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -789,7 +1004,7 @@ func (c *BotClient) RetrieveBot(ctx context.Context, botID string) (*Bot, error)
 	path := fmt.Sprintf("bot/%s", botID)
 
 	// Make the request
-	res, err := c.client.request(ctx, http.MethodGet, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodGet, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve bot: %w", err)
 	}
@@ -797,9 +1012,10 @@ func (c *BotClient) RetrieveBot(ctx context.Context, botID string) (*Bot, error)
 
 	// Decode the response
 	var bot Bot
-	if err := json.NewDecoder(res.Body).Decode(&bot); err != nil {
+	if err := c.client.decode(res, &bot); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	c.client.remember(&bot)
 
 	return &bot, nil
 }
@@ -810,8 +1026,13 @@ func (c *BotClient) UpdateScheduledBot(ctx context.Context, botID string, reques
 	// Construct the URL path with the bot_id
 	path := fmt.Sprintf("bot/%s", botID)
 
+	request, err := c.client.withZoomTokens(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
 	// Make the request
-	res, err := c.client.request(ctx, http.MethodPatch, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPatch, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update scheduled bot: %w", err)
 	}
@@ -819,9 +1040,10 @@ func (c *BotClient) UpdateScheduledBot(ctx context.Context, botID string, reques
 
 	// Decode the response
 	var bot Bot
-	if err := json.NewDecoder(res.Body).Decode(&bot); err != nil {
+	if err := c.client.decode(res, &bot); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	c.client.remember(&bot)
 
 	return &bot, nil
 }
@@ -833,17 +1055,13 @@ func (c *BotClient) DeleteScheduledBot(ctx context.Context, botID string) error
 	path := fmt.Sprintf("bot/%s", botID)
 
 	// Make the request
-	res, err := c.client.request(ctx, http.MethodDelete, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(bodiless(ctx), http.MethodDelete, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return fmt.Errorf("failed to delete scheduled bot: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
+	c.client.forgetBot(botID)
 	return nil
 }
 
@@ -854,42 +1072,64 @@ func (c *BotClient) DeleteBotMedia(ctx context.Context, botID string) error {
 	path := fmt.Sprintf("bot/%s/delete_media", botID)
 
 	// Make the request
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(bodiless(ctx), http.MethodPost, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return fmt.Errorf("failed to delete bot media: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
+	c.client.forgetBot(botID)
 	return nil
 }
 
-// Get the results of additional analysis specified by the intelligence parameter. If the call is not yet complete, this returns results from any real-time analysis performed so-far.
-// Not implemented yet
+// GetBotIntelligence gets the results of additional analysis specified by the intelligence parameter.
+// If the call is not yet complete, this returns results from any real-time analysis performed so-far.
 // see https://docs.recall.ai/reference/bot_intelligence_retrieve
-// func (c *BotClient) GetBotIntelligence(ctx context.Context, botID string) (*IntelligenceResult, error) {
-// 	// TODO: Implement this method
-// 	return nil, nil
-// }
+func (c *BotClient) GetBotIntelligence(ctx context.Context, botID string) (*IntelligenceResult, error) {
+	path := fmt.Sprintf("bot/%s/intelligence", botID)
+
+	res, err := c.client.request(ctx, http.MethodGet, path, nil, nil, APIVersionV1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bot intelligence: %w", err)
+	}
+	defer res.Body.Close()
+
+	var result IntelligenceResult
+	if err := c.client.decode(res, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
 
 // RemoveBotFromCall removes the bot from a call by its ID.
 // This action is irreversible.
 // see https://docs.recall.ai/reference/bot_leave_call_create
-// Not implemented yet
-// func (c *BotClient) RemoveBotFromCall(ctx context.Context, botID string) error {
-// 	// TODO: Implement this method
-// 	return nil
-// }
+func (c *BotClient) RemoveBotFromCall(ctx context.Context, botID string) (*Bot, error) {
+	// Construct the URL path with the bot_id
+	path := fmt.Sprintf("bot/%s/leave_call", botID)
+
+	// Make the POST request to remove the bot from the call
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, APIVersionV1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove bot from call: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Decode the response body into a Bot
+	var response Bot
+	if err := c.client.decode(res, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
 
 // LogEntry represents a single log entry with level, message, and created_at fields.
 type LogEntry struct {
 	Level     string `json:"level"`
 	Message   string `json:"message"`
-	CreatedAt string `json:"created_at"`
+	CreatedAt Time   `json:"created_at"`
 }
 
 // GetBotLogs retrieves the logs produced by the bot by its ID.
@@ -899,20 +1139,15 @@ func (c *BotClient) GetBotLogs(ctx context.Context, botID string) (*LogEntry, er
 	path := fmt.Sprintf("bot/%s/logs", botID)
 
 	// Make the request
-	res, err := c.client.request(ctx, http.MethodGet, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodGet, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bot logs: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a slice of LogEntry
 	var log LogEntry
-	if err := json.NewDecoder(res.Body).Decode(&log); err != nil {
+	if err := c.client.decode(res, &log); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -929,6 +1164,9 @@ const (
 type OutputAudioRequest struct {
 	Kind    OutputAudioKind `json:"kind" `
 	B64Data string          `json:"b64_data"`
+	// Source is read instead of B64Data if set, base64-encoding the audio while the request
+	// is sent, so that long clips are not held in memory. Requests with a Source are not retried.
+	Source io.Reader `json:"-"`
 }
 
 // OutputAudio causes the bot to output audio.
@@ -938,20 +1176,15 @@ func (c *BotClient) OutputAudio(ctx context.Context, botID string, request *Outp
 	path := fmt.Sprintf("bot/%s/output_audio", botID)
 
 	// Make the request with the provided OutputAudioRequest
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to output audio: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -965,17 +1198,12 @@ func (c *BotClient) StopOutputAudio(ctx context.Context, botID string) error {
 	path := fmt.Sprintf("bot/%s/output_audio", botID)
 
 	// Make the DELETE request to stop outputting audio
-	res, err := c.client.request(ctx, http.MethodDelete, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(bodiless(ctx), http.MethodDelete, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return fmt.Errorf("failed to stop output audio: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	return nil
 }
 
@@ -986,20 +1214,15 @@ func (c *BotClient) OutputMedia(ctx context.Context, botID string, request *Outp
 	path := fmt.Sprintf("bot/%s/output_media", botID)
 
 	// Make the request with the provided OutputMediaRequest
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to output media: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1013,30 +1236,29 @@ func (c *BotClient) StopOutputMedia(ctx context.Context, botID string) error {
 	path := fmt.Sprintf("bot/%s/output_media", botID)
 
 	// Make the DELETE request to stop outputting media
-	res, err := c.client.request(ctx, http.MethodDelete, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(bodiless(ctx), http.MethodDelete, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return fmt.Errorf("failed to stop output media: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	return nil
 }
 
 type OutputVideoKind string
 
 const (
-	OutputVideoKindJpeg OutputAudioKind = "jpeg"
+	OutputVideoKindJpeg OutputVideoKind = "jpeg"
 )
 
-// OutputAudioRequest represents the request body for the OutputAudio method.
+// OutputVideoRequest represents the request body for the OutputVideo and StartScreenshare methods.
+// NewOutputVideoRequest builds one from an image in the expected format.
 type OutputVideoRequest struct {
 	Kind    OutputVideoKind `json:"kind" `
 	B64Data string          `json:"b64_data"`
+	// Source is read instead of B64Data if set, base64-encoding the image while the request
+	// is sent, so that it is not held in memory. Requests with a Source are not retried.
+	Source io.Reader `json:"-"`
 }
 
 // StartScreenshare causes the bot to start screensharing.
@@ -1046,26 +1268,41 @@ func (c *BotClient) StartScreenshare(ctx context.Context, botID string, request
 	path := fmt.Sprintf("bot/%s/output_screenshare", botID)
 
 	// Make the POST request with the provided OutputVideoRequest
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start screenshare: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
 	return &response, nil
 }
 
+// StartScreenshareFromFile causes the bot to start screensharing a PNG or JPEG image file, e.g. a slide.
+// The image is converted like NewOutputVideoRequestFromFile.
+func (c *BotClient) StartScreenshareFromFile(ctx context.Context, botID string, name string) (*Bot, error) {
+	request, err := NewOutputVideoRequestFromFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return c.StartScreenshare(ctx, botID, request)
+}
+
+// StartScreenshareFromReader causes the bot to start screensharing a PNG or JPEG image read from r.
+// The image is converted like NewOutputVideoRequestFromReader.
+func (c *BotClient) StartScreenshareFromReader(ctx context.Context, botID string, r io.Reader) (*Bot, error) {
+	request, err := NewOutputVideoRequestFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.StartScreenshare(ctx, botID, request)
+}
+
 // StopScreenshare causes the bot to stop screensharing.
 // see https://docs.recall.ai/reference/bot_output_screenshare_destroy
 func (c *BotClient) StopScreenshare(ctx context.Context, botID string) error {
@@ -1073,17 +1310,12 @@ func (c *BotClient) StopScreenshare(ctx context.Context, botID string) error {
 	path := fmt.Sprintf("bot/%s/output_screenshare", botID)
 
 	// Make the DELETE request to stop screensharing
-	res, err := c.client.request(ctx, http.MethodDelete, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(bodiless(ctx), http.MethodDelete, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return fmt.Errorf("failed to stop screenshare: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	return nil
 }
 
@@ -1094,20 +1326,15 @@ func (c *BotClient) OutputVideo(ctx context.Context, botID string, request *Outp
 	path := fmt.Sprintf("bot/%s/output_video", botID)
 
 	// Make the POST request with the provided OutputVideoRequest
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to output video: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1121,17 +1348,12 @@ func (c *BotClient) StopOutputVideo(ctx context.Context, botID string) error {
 	path := fmt.Sprintf("bot/%s/output_video", botID)
 
 	// Make the DELETE request to stop outputting video
-	res, err := c.client.request(ctx, http.MethodDelete, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(bodiless(ctx), http.MethodDelete, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return fmt.Errorf("failed to stop output video: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	return nil
 }
 
@@ -1142,20 +1364,15 @@ func (c *BotClient) PauseRecording(ctx context.Context, botID string) (*Bot, err
 	path := fmt.Sprintf("bot/%s/pause_recording", botID)
 
 	// Make the POST request to pause the recording
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pause recording: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1170,20 +1387,15 @@ func (c *BotClient) RequestRecordingPermission(ctx context.Context, botID string
 	path := fmt.Sprintf("bot/%s/request_recording_permission", botID)
 
 	// Make the POST request to request recording permission
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to request recording permission: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1197,20 +1409,15 @@ func (c *BotClient) ResumeRecording(ctx context.Context, botID string) (*Bot, er
 	path := fmt.Sprintf("bot/%s/resume_recording", botID)
 
 	// Make the POST request to resume the recording
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resume recording: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1235,20 +1442,15 @@ func (c *BotClient) SendChatMessage(ctx context.Context, botID string, request *
 	path := fmt.Sprintf("bot/%s/send_chat_message", botID)
 
 	// Make the POST request to send the chat message
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send chat message: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1261,11 +1463,24 @@ type GetSpeakerTimelineParams struct {
 
 // SpeakerTimelineEntry represents a single entry in the speaker timeline.
 type SpeakerTimelineEntry struct {
-	Name      string  `json:"name"`
-	UserID    int     `json:"user_id"`
+	Name   string `json:"name"`
+	UserID int    `json:"user_id"`
+	// The seconds since the start of the recording at which the speaker started speaking.
+	// Offset and At convert it.
 	Timestamp float64 `json:"timestamp"`
 }
 
+// Offset returns the time since the start of the recording at which the speaker started speaking.
+func (e SpeakerTimelineEntry) Offset() time.Duration {
+	return secondsToDuration(e.Timestamp)
+}
+
+// At returns the wall-clock time at which the speaker started speaking, given the start of the
+// recording, e.g. the StartedAt of bot.LatestRecording().
+func (e SpeakerTimelineEntry) At(recordingStart time.Time) time.Time {
+	return recordingStart.Add(e.Offset())
+}
+
 // GetSpeakerTimeline retrieves the speaker timeline produced by the bot.
 // If the call is not yet complete, this returns the speaker timeline so-far.
 // see https://docs.recall.ai/reference/bot_speaker_timeline_list
@@ -1274,26 +1489,21 @@ func (c *BotClient) GetSpeakerTimeline(ctx context.Context, botID string, params
 	path := fmt.Sprintf("bot/%s/speaker_timeline", botID)
 
 	// Prepare query parameters
-	queryParams := make(map[string][]string)
-	if len(params) > 0 && params[0].ExcludeNullSpeaker {
-		queryParams["exclude_null_speaker"] = []string{"true"}
+	q := &query{}
+	if len(params) > 0 {
+		q.addTrue("exclude_null_speaker", params[0].ExcludeNullSpeaker)
 	}
 
 	// Make the GET request to retrieve the speaker timeline
-	res, err := c.client.request(ctx, http.MethodGet, path, queryParams, nil, apiVersionV1)
+	res, err := c.client.cachedRequest(ctx, botID, path, q)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get speaker timeline: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a slice of SpeakerTimelineEntry
 	var timeline []SpeakerTimelineEntry
-	if err := json.NewDecoder(res.Body).Decode(&timeline); err != nil {
+	if err := c.client.decode(res, &timeline); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1317,20 +1527,15 @@ func (c *BotClient) StartRecording(ctx context.Context, botID string, request *S
 	path := fmt.Sprintf("bot/%s/start_recording", botID)
 
 	// Make the POST request with the provided StartRecordingRequest
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start recording: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1344,20 +1549,15 @@ func (c *BotClient) StopRecording(ctx context.Context, botID string) (*Bot, erro
 	path := fmt.Sprintf("bot/%s/stop_recording", botID)
 
 	// Make the POST request to stop recording
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, apiVersionV1)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, nil, APIVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to stop recording: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a Bot
 	var response Bot
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -1367,6 +1567,23 @@ func (c *BotClient) StopRecording(ctx context.Context, botID string) (*Bot, erro
 // GetTranscriptParams represents the query parameters for the GetTranscript method.
 type GetBotTranscriptParams struct {
 	EnhancedDiarization bool
+	// Return the transcript produced by AnalyzeBotMedia instead of the real-time one.
+	UseAsyncTranscription bool
+	// Only return words starting after this many seconds since the start of the recording.
+	// The API has no such filter, so the transcript is sliced after it has been fetched;
+	// this keeps pollers from re-processing entries they have already seen.
+	After float64
+}
+
+func buildTranscriptQueryParams(params []GetBotTranscriptParams) *query {
+	if len(params) == 0 {
+		return nil
+	}
+
+	q := &query{}
+	q.addTrue("enhanced_diarization", params[0].EnhancedDiarization)
+	q.addTrue("use_async_transcription", params[0].UseAsyncTranscription)
+	return q
 }
 
 // TranscriptEntry represents a single entry in the bot's transcript.
@@ -1375,15 +1592,75 @@ type TranscriptEntry struct {
 	SpeakerID int          `json:"speaker_id"`
 	Language  string       `json:"language"`
 	Words     []WordDetail `json:"words"`
+	// The entry as returned by the API, including provider-specific fields
+	// that are not part of the normalized entry, such as sentiment.
+	// It is only set by clients created with WithRawTranscripts.
+	Raw json.RawMessage `json:"-"`
 }
 
 // WordDetail represents the details of a word in the transcript.
 type WordDetail struct {
-	Text           string  `json:"text"`
+	Text string `json:"text"`
+	// The start time in seconds since the start of the recording.
 	StartTimestamp float64 `json:"start_timestamp"`
-	EndTimestamp   float64 `json:"end_timestamp"`
-	Language       string  `json:"language"`
-	Confidence     float64 `json:"confidence"`
+	// The end time in seconds since the start of the recording.
+	EndTimestamp float64 `json:"end_timestamp"`
+	Language     string  `json:"language"`
+	Confidence   float64 `json:"confidence"`
+}
+
+// UnmarshalJSON decodes the word, accepting the timestamps of meeting caption transcripts,
+// which are objects with the seconds since the start of the recording as "relative".
+func (w *WordDetail) UnmarshalJSON(data []byte) error {
+	type word WordDetail
+	v := struct {
+		*word
+		StartTimestamp wordTimestamp `json:"start_timestamp"`
+		EndTimestamp   wordTimestamp `json:"end_timestamp"`
+	}{word: (*word)(w)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	w.StartTimestamp = float64(v.StartTimestamp)
+	w.EndTimestamp = float64(v.EndTimestamp)
+	return nil
+}
+
+// checkFields checks the fields of the word for strict decoding, see WithStrictDecoding.
+func (w *WordDetail) checkFields(data []byte, path string) error {
+	type word WordDetail
+	var v struct {
+		*word
+		StartTimestamp wordTimestamp `json:"start_timestamp"`
+		EndTimestamp   wordTimestamp `json:"end_timestamp"`
+	}
+	return checkFields(data, reflect.TypeOf(v), path)
+}
+
+// wordTimestamp is the timestamp of a word in seconds, given either as a number or as an object
+// with a "relative" number.
+type wordTimestamp float64
+
+func (t *wordTimestamp) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '{' {
+		return json.Unmarshal(data, (*float64)(t))
+	}
+
+	var v struct {
+		Relative float64 `json:"relative"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*t = wordTimestamp(v.Relative)
+	return nil
+}
+
+func (t *wordTimestamp) checkFields(data []byte, path string) error {
+	var v struct {
+		Relative float64 `json:"relative"`
+	}
+	return checkFields(data, reflect.TypeOf(v), path)
 }
 
 // GetBotTranscript retrieves the transcript produced by the bot by its ID.
@@ -1393,77 +1670,319 @@ func (c *BotClient) GetBotTranscript(ctx context.Context, botID string, params .
 	path := fmt.Sprintf("bot/%s/transcript", botID)
 
 	// Prepare query parameters
-	queryParams := make(map[string][]string)
-	if len(params) > 0 && params[0].EnhancedDiarization {
-		queryParams["enhanced_diarization"] = []string{"true"}
+	q := buildTranscriptQueryParams(params)
+
+	// Make the GET request with the query parameters; async transcripts change with every analysis,
+	// so only the transcript of the call itself is cached
+	var (
+		res *http.Response
+		err error
+	)
+	if len(params) > 0 && params[0].UseAsyncTranscription {
+		res, err = c.client.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
+	} else {
+		res, err = c.client.cachedRequest(ctx, botID, path, q)
 	}
-
-	// Make the GET request with the query parameters
-	res, err := c.client.request(ctx, http.MethodGet, path, queryParams, nil, apiVersionV1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bot transcript: %w", err)
 	}
 	defer res.Body.Close()
 
-	// Check for successful response
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
-	}
-
 	// Decode the response body into a slice of TranscriptEntry
 	var transcript []TranscriptEntry
-	if err := json.NewDecoder(res.Body).Decode(&transcript); err != nil {
+	if err := c.client.decodeTranscript(res, &transcript); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	if len(params) > 0 && params[0].After > 0 {
+		transcript = transcriptAfter(transcript, params[0].After)
+	}
+
 	return transcript, nil
 }
 
+// StreamBotTranscript retrieves the transcript produced by the bot by its ID and calls fn with each entry
+// as it is decoded, so long transcripts are never held in memory at once.
+// Returning an error from fn stops decoding and returns that error.
+// see https://docs.recall.ai/reference/bot_transcript_list
+func (c *BotClient) StreamBotTranscript(ctx context.Context, botID string, fn func(TranscriptEntry) error, params ...GetBotTranscriptParams) error {
+	// Construct the URL path with the bot_id
+	path := fmt.Sprintf("bot/%s/transcript", botID)
+
+	// Prepare query parameters
+	q := buildTranscriptQueryParams(params)
+
+	// Make the GET request with the query parameters
+	res, err := c.client.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
+	if err != nil {
+		return fmt.Errorf("failed to get bot transcript: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Decode the array one entry at a time
+	dec := c.client.newDecoder(res.Body)
+	if tok, err := dec.Token(); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	} else if tok != json.Delim('[') {
+		return fmt.Errorf("failed to decode response: unexpected token %v", tok)
+	}
+	for dec.More() {
+		var entry TranscriptEntry
+		if err := c.client.decodeTranscriptEntry(res, dec, &entry); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		if len(params) > 0 && params[0].After > 0 {
+			sliced := transcriptAfter([]TranscriptEntry{entry}, params[0].After)
+			if len(sliced) == 0 {
+				continue
+			}
+			entry = sliced[0]
+		}
+
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+type ListBotScreenshotsParams struct {
+	Cursor string
+}
+
+// Screenshot represents a screenshot taken by the bot during the call.
+type Screenshot struct {
+	ID         string `json:"id"`
+	RecordedAt Time   `json:"recorded_at"`
+	// The pre-signed URL of the image.
+	Image string `json:"image"`
+}
+
+type ListScreenshotsResponse struct {
+	Next     string       `json:"next"`
+	Previous string       `json:"previous"`
+	Results  []Screenshot `json:"results"`
+}
+
+// ListBotScreenshots retrieves the screenshots taken by the bot.
+// see https://docs.recall.ai/reference/bot_screenshots_list
+func (c *BotClient) ListBotScreenshots(ctx context.Context, botID string, params ...ListBotScreenshotsParams) (*ListScreenshotsResponse, error) {
+	// Construct the URL path with the bot_id
+	path := fmt.Sprintf("bot/%s/screenshots", botID)
+
+	// Prepare query parameters
+	q := &query{}
+	if len(params) > 0 {
+		q.addString("cursor", params[0].Cursor)
+	}
+
+	// Make the GET request to list the screenshots
+	res, err := c.client.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bot screenshots: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Decode the response
+	var response ListScreenshotsResponse
+	if err := c.client.decode(res, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// AnalyzeBotMediaRequest selects the provider that analyzes the media of a bot.
+// Exactly one provider must be set.
 type AnalyzeBotMediaRequest struct {
 	// Transcription requests for various services.
-	AssemblyAIAsyncTranscription   AssemblyAIAsyncTranscription   `json:"assemblyai_async_transcription"`
-	SpeechmaticsAsyncTranscription SpeechmaticsAsyncTranscription `json:"speechmatics_async_transcription"`
-	RevAsyncTranscription          RevAsyncTranscription          `json:"rev_async_transcription"`
-	DeepgramAsyncTranscription     DeepgramAsyncTranscription     `json:"deepgram_async_transcription"`
+	AssemblyAIAsyncTranscription   *AssemblyAIAsyncTranscription   `json:"assemblyai_async_transcription,omitempty"`
+	SpeechmaticsAsyncTranscription *SpeechmaticsAsyncTranscription `json:"speechmatics_async_transcription,omitempty"`
+	RevAsyncTranscription          *RevAsyncTranscription          `json:"rev_async_transcription,omitempty"`
+	DeepgramAsyncTranscription     *DeepgramAsyncTranscription     `json:"deepgram_async_transcription,omitempty"`
+	GladiaAsyncTranscription       *GladiaAsyncTranscription       `json:"gladia_async_transcription,omitempty"`
+	GladiaV2AsyncTranscription     *GladiaV2AsyncTranscription     `json:"gladia_v2_async_transcription,omitempty"`
+}
+
+// Validate checks that exactly one provider is set.
+func (r *AnalyzeBotMediaRequest) Validate() error {
+	var providers int
+	for _, set := range []bool{
+		r.AssemblyAIAsyncTranscription != nil,
+		r.SpeechmaticsAsyncTranscription != nil,
+		r.RevAsyncTranscription != nil,
+		r.DeepgramAsyncTranscription != nil,
+		r.GladiaAsyncTranscription != nil,
+		r.GladiaV2AsyncTranscription != nil,
+	} {
+		if set {
+			providers++
+		}
+	}
+
+	switch providers {
+	case 0:
+		return fmt.Errorf("a provider is required")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("only one provider can be set, got %d", providers)
+	}
+}
+
+// SetCredentialID sets the credential of the selected provider, to run the analysis with
+// provider credentials owned by the customer instead of the default ones.
+func (r *AnalyzeBotMediaRequest) SetCredentialID(credentialID string) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	switch {
+	case r.AssemblyAIAsyncTranscription != nil:
+		r.AssemblyAIAsyncTranscription.CredentialID = credentialID
+	case r.SpeechmaticsAsyncTranscription != nil:
+		r.SpeechmaticsAsyncTranscription.CredentialID = credentialID
+	case r.RevAsyncTranscription != nil:
+		r.RevAsyncTranscription.CredentialID = credentialID
+	case r.DeepgramAsyncTranscription != nil:
+		r.DeepgramAsyncTranscription.CredentialID = credentialID
+	case r.GladiaAsyncTranscription != nil:
+		r.GladiaAsyncTranscription.CredentialID = credentialID
+	case r.GladiaV2AsyncTranscription != nil:
+		r.GladiaV2AsyncTranscription.CredentialID = credentialID
+	}
+	return nil
+}
+
+// Ptr returns a pointer to v, for optional request fields such as AssemblyAIAsyncTranscription.Punctuate.
+func Ptr[T any](v T) *T {
+	return &v
 }
 
 // AssemblyAIAsyncTranscription represents the request for asynchronous transcription using AssemblyAI.
+// Unset fields are omitted so that the AssemblyAI defaults apply. Options that default to true
+// at AssemblyAI are pointers, so they can be disabled explicitly.
 type AssemblyAIAsyncTranscription struct {
 	// Language specifies the language of the audio.
 	// Refer to: https://www.assemblyai.com/docs/speech-to-text/supported-languages
-	Language string `json:"language"`
+	Language string `json:"language,omitempty"`
+
+	// LanguageDetection enables automatic detection of the language of the audio.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.language_detection
+	LanguageDetection bool `json:"language_detection,omitempty"`
 
 	// AudioEndAt indicates the timestamp to stop processing the audio.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.audio_end_at
-	AudioEndAt int `json:"audio_end_at"`
+	AudioEndAt int `json:"audio_end_at,omitempty"`
 
 	// AudioStartFrom indicates the timestamp to start processing the audio.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.audio_start_from
-	AudioStartFrom int `json:"audio_start_from"`
+	AudioStartFrom int `json:"audio_start_from,omitempty"`
 
 	// AutoChapters enables automatic chapter detection in the transcription.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.auto_chapters
-	AutoChapters bool `json:"auto_chapters"`
+	AutoChapters bool `json:"auto_chapters,omitempty"`
 
 	// AutoHighlights enables automatic highlights detection in the transcription.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.auto_highlights
-	AutoHighlights bool `json:"auto_highlights"`
+	AutoHighlights bool `json:"auto_highlights,omitempty"`
 
 	// BoostParam specifies the boost parameter to enhance transcription accuracy.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.boost_param
-	BoostParam string `json:"boost_param"`
+	BoostParam string `json:"boost_param,omitempty"`
 
 	// ContentSafety enables analysis for potentially sensitive content.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.content_safety
-	ContentSafety bool `json:"content_safety"`
+	ContentSafety bool `json:"content_safety,omitempty"`
 
 	// ContentSafetyConfidence sets the confidence threshold for content safety analysis.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.content_safety_confidence
-	ContentSafetyConfidence int `json:"content_safety_confidence"`
+	ContentSafetyConfidence int `json:"content_safety_confidence,omitempty"`
 
 	// CustomSpelling allows configuration of custom spelling for specific words.
 	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.custom_spelling
-	CustomSpelling []map[string]string `json:"custom_spelling"`
+	CustomSpelling []map[string]string `json:"custom_spelling,omitempty"`
+
+	// Disfluencies keeps filler words like "um" in the transcription.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.disfluencies
+	Disfluencies bool `json:"disfluencies,omitempty"`
+
+	// EntityDetection enables detection of entities such as names and locations.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.entity_detection
+	EntityDetection bool `json:"entity_detection,omitempty"`
+
+	// FilterProfanity replaces profanity with asterisks.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.filter_profanity
+	FilterProfanity bool `json:"filter_profanity,omitempty"`
+
+	// FormatText enables text formatting. Defaults to true.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.format_text
+	FormatText *bool `json:"format_text,omitempty"`
+
+	// IABCategories enables topic detection based on the IAB taxonomy.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.iab_categories
+	IABCategories bool `json:"iab_categories,omitempty"`
+
+	// Punctuate enables automatic punctuation. Defaults to true.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.punctuate
+	Punctuate *bool `json:"punctuate,omitempty"`
+
+	// RedactPII redacts personally identifiable information from the transcription.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.redact_pii
+	RedactPII bool `json:"redact_pii,omitempty"`
+
+	// RedactPIIAudio creates a copy of the audio with the PII beeped out.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.redact_pii_audio
+	RedactPIIAudio bool `json:"redact_pii_audio,omitempty"`
+
+	// RedactPIIPolicies specifies the kinds of PII to redact, e.g. "email_address" or "phone_number".
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.redact_pii_policies
+	RedactPIIPolicies []string `json:"redact_pii_policies,omitempty"`
+
+	// RedactPIISub specifies how PII is replaced, either "entity_name" or "hash".
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.redact_pii_sub
+	RedactPIISub string `json:"redact_pii_sub,omitempty"`
+
+	// SentimentAnalysis enables sentiment analysis of each sentence.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.sentiment_analysis
+	SentimentAnalysis bool `json:"sentiment_analysis,omitempty"`
+
+	// SpeakerLabels enables speaker diarization.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.speaker_labels
+	SpeakerLabels bool `json:"speaker_labels,omitempty"`
+
+	// SpeakersExpected tells the speaker diarization how many speakers to expect.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.speakers_expected
+	SpeakersExpected int `json:"speakers_expected,omitempty"`
+
+	// SpeechThreshold rejects audio files with less than this fraction of speech, between 0 and 1.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.speech_threshold
+	SpeechThreshold *float64 `json:"speech_threshold,omitempty"`
+
+	// Summarization enables summarization of the transcription.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.summarization
+	Summarization bool `json:"summarization,omitempty"`
+
+	// SummaryModel specifies the model used for summarization, e.g. "informative" or "conversational".
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.summary_model
+	SummaryModel string `json:"summary_model,omitempty"`
+
+	// SummaryType specifies the type of the summary, e.g. "bullets" or "paragraph".
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.summary_type
+	SummaryType string `json:"summary_type,omitempty"`
+
+	// WordBoost lists words and phrases whose recognition is boosted, see BoostParam.
+	// Refer to: https://www.assemblyai.com/docs/api-reference/transcripts/submit#request.body.word_boost
+	WordBoost []string `json:"word_boost,omitempty"`
+
+	// CredentialID specifies the ID of the AssemblyAI credential to use for this transcription.
+	// If not specified, the default credential will be used.
+	CredentialID string `json:"credential_id,omitempty"`
 }
 
 // SpeechmaticsAsyncTranscription represents the request for asynchronous transcription using Speechmatics.
@@ -1487,6 +2006,10 @@ type SpeechmaticsAsyncTranscription struct {
 	// AdditionalVocab is a list of custom words or phrases that should be recognized.
 	// Alternative pronunciations can be specified to aid recognition.
 	AdditionalVocab []map[string]string `json:"additional_vocab"`
+
+	// CredentialID specifies the ID of the Speechmatics credential to use for this transcription.
+	// If not specified, the default credential will be used.
+	CredentialID string `json:"credential_id,omitempty"`
 }
 
 // RevAsyncTranscription represents the request for asynchronous transcription using Rev.
@@ -1530,6 +2053,10 @@ type RevAsyncTranscription struct {
 	// CustomVocabularies is a list of custom vocabulary strings to be used.
 	// Docs: https://docs.rev.ai/api/asynchronous/reference/#operation/SubmitTranscriptionJob!ct=application/json&path=custom_vocabularies&t=request
 	CustomVocabularies []string `json:"custom_vocabularies"`
+
+	// CredentialID specifies the ID of the Rev credential to use for this transcription.
+	// If not specified, the default credential will be used.
+	CredentialID string `json:"credential_id,omitempty"`
 }
 
 // DeepgramAsyncTranscription represents the request for asynchronous transcription using Deepgram.
@@ -1608,44 +2135,83 @@ type DeepgramAsyncTranscription struct {
 
 	// CredentialID specifies the ID of the Deepgram credential to use for this transcription.
 	// If not specified, the default credential will be used.
-	CredentialID string `json:"credential_id"`
+	CredentialID string `json:"credential_id,omitempty"`
+}
+
+// GladiaAsyncTranscription represents the request for asynchronous transcription using Gladia.
+type GladiaAsyncTranscription struct {
+	// LanguageBehaviour specifies how the language is determined, e.g. "automatic single language",
+	// "automatic multiple languages" or "manual".
+	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/languages
+	LanguageBehaviour string `json:"language_behaviour,omitempty"`
+
+	// Language specifies the language of the audio when LanguageBehaviour is "manual".
+	Language string `json:"language,omitempty"`
+
+	// TranscriptionHint is a context prompt to improve the accuracy of the transcription.
+	TranscriptionHint string `json:"transcription_hint,omitempty"`
+
+	// ToggleDiarization enables speaker diarization in the transcription.
+	ToggleDiarization bool `json:"toggle_diarization,omitempty"`
+
+	// DiarizationMaxSpeakers sets the maximum number of speakers to be detected.
+	DiarizationMaxSpeakers int `json:"diarization_max_speakers,omitempty"`
+
+	// ToggleDirectTranslate enables the translation of the transcription.
+	ToggleDirectTranslate bool `json:"toggle_direct_translate,omitempty"`
+
+	// TargetTranslationLanguage specifies the language to translate the transcription to.
+	TargetTranslationLanguage string `json:"target_translation_language,omitempty"`
+
+	// ToggleNoiseReduction enables noise reduction of the audio.
+	ToggleNoiseReduction bool `json:"toggle_noise_reduction,omitempty"`
+
+	// ToggleSummarization enables summarization of the transcription.
+	ToggleSummarization bool `json:"toggle_summarization,omitempty"`
+
+	// ToggleChapterization enables chapterization of the transcription.
+	ToggleChapterization bool `json:"toggle_chapterization,omitempty"`
+
+	// CredentialID specifies the ID of the Gladia credential to use for this transcription.
+	// If not specified, the default credential will be used.
+	CredentialID string `json:"credential_id,omitempty"`
 }
 
 // GladiaV2AsyncTranscription represents the request for asynchronous transcription using Gladia V2.
 type GladiaV2AsyncTranscription struct {
 	// ContextPrompt is a string used for context in transcription.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#context-prompt
-	ContextPrompt string `json:"context_prompt"`
+	ContextPrompt string `json:"context_prompt,omitempty"`
 
 	// CustomVocabulary can be a boolean or a list of vocabulary items.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#custom-vocabulary
-	CustomVocabulary interface{} `json:"custom_vocabulary"` // Can be bool or list
+	CustomVocabulary interface{} `json:"custom_vocabulary,omitempty"` // Can be bool or list
 
 	// CustomVocabularyConfig holds configuration for custom vocabulary.
-	CustomVocabularyConfig CustomVocabularyConfig `json:"custom_vocabulary_config"`
+	CustomVocabularyConfig *CustomVocabularyConfig `json:"custom_vocabulary_config,omitempty"`
 
 	// EnableCodeSwitching allows detection of multiple languages.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#multiple-languages-detection-code-switching
 	EnableCodeSwitching bool `json:"enable_code_switching"`
 
 	// CodeSwitchingConfig holds configuration for guided code switching.
-	CodeSwitchingConfig CodeSwitchingConfig `json:"code_switching_config"`
+	CodeSwitchingConfig *CodeSwitchingConfig `json:"code_switching_config,omitempty"`
 
 	// Subtitles enables the export of caption files.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#export-srt-or-vtt-caption-files
 	Subtitles bool `json:"subtitles"`
 
 	// SubtitlesConfig holds configuration for subtitles.
-	SubtitlesConfig SubtitlesConfig `json:"subtitles_config"`
+	SubtitlesConfig *SubtitlesConfig `json:"subtitles_config,omitempty"`
 
 	// DiarizationConfig holds configuration for speaker diarization.
-	DiarizationConfig DiarizationConfig `json:"diarization_config"`
+	DiarizationConfig *DiarizationConfig `json:"diarization_config,omitempty"`
 
 	// TranslationConfig holds configuration for translation.
-	TranslationConfig TranslationConfig `json:"translation_config"`
+	TranslationConfig *TranslationConfig `json:"translation_config,omitempty"`
 
 	// SummarizationConfig holds configuration for summarization.
-	SummarizationConfig SummarizationConfig `json:"summarization_config"`
+	SummarizationConfig *SummarizationConfig `json:"summarization_config,omitempty"`
 
 	// Moderation enables content moderation.
 	// Docs: https://docs.gladia.io/chapters/audio-intelligence/pages/moderation
@@ -1669,7 +2235,7 @@ type GladiaV2AsyncTranscription struct {
 
 	// CustomSpellingConfig holds configuration for custom spelling.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#custom-spelling
-	CustomSpellingConfig string `json:"custom_spelling_config"`
+	CustomSpellingConfig string `json:"custom_spelling_config,omitempty"`
 
 	// StructuredDataExtraction enables extraction of structured data.
 	// Docs: https://docs.gladia.io/chapters/audio-intelligence/pages/structured%20data%20extraction
@@ -1677,7 +2243,7 @@ type GladiaV2AsyncTranscription struct {
 
 	// StructuredDataExtractionConfig holds configuration for structured data extraction.
 	// Docs: https://docs.gladia.io/chapters/audio-intelligence/pages/structured%20data%20extraction
-	StructuredDataExtractionConfig string `json:"structured_data_extraction_config"`
+	StructuredDataExtractionConfig string `json:"structured_data_extraction_config,omitempty"`
 
 	// SentimentAnalysis enables sentiment analysis.
 	// Docs: https://docs.gladia.io/chapters/audio-intelligence/pages/sentiment%20analysis
@@ -1688,11 +2254,11 @@ type GladiaV2AsyncTranscription struct {
 	AudioToLLM bool `json:"audio_to_llm"`
 
 	// AudioToLLMConfig holds configuration for audio to language model processing.
-	AudioToLLMConfig AudioToLLMConfig `json:"audio_to_llm_config"`
+	AudioToLLMConfig *AudioToLLMConfig `json:"audio_to_llm_config,omitempty"`
 
 	// CustomMetadata allows adding custom metadata to transcription.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#adding-custom-metadata
-	CustomMetadata string `json:"custom_metadata"`
+	CustomMetadata string `json:"custom_metadata,omitempty"`
 
 	// Sentences enables sentence-level transcription.
 	// Docs: https://docs.gladia.io/chapters/speech-to-text-api/pages/speech-recognition#sentences
@@ -1705,6 +2271,10 @@ type GladiaV2AsyncTranscription struct {
 	// PunctuationEnhanced enables enhanced punctuation in transcription.
 	// Add comment: This field is used to enhance punctuation in the transcription.
 	PunctuationEnhanced bool `json:"punctuation_enhanced"`
+
+	// CredentialID specifies the ID of the Gladia credential to use for this transcription.
+	// If not specified, the default credential will be used.
+	CredentialID string `json:"credential_id,omitempty"`
 }
 
 // CustomVocabularyConfig holds configuration for custom vocabulary.
@@ -1803,16 +2373,35 @@ type AudioToLLMConfig struct {
 // AnalyzeBotMediaResponse represents the response for the AnalyzeBotMedia method.
 type AnalyzeBotMediaResponse struct {
 	JobId string `json:"job_id"`
+	// The API version the job was submitted to.
+	APIVersion APIVersion `json:"-"`
+}
+
+// AnalyzeBotMediaOptions configures the AnalyzeBotMedia method.
+type AnalyzeBotMediaOptions struct {
+	// The API version of the analysis route. Defaults to APIVersionV2Beta.
+	APIVersion APIVersion
 }
 
 // AnalyzeBotMedia runs analysis on the bot's media.
-// Not implemented yet
 // see https://docs.recall.ai/reference/bot_analyze_create
-func (c *BotClient) AnalyzeBotMedia(ctx context.Context, botId string, request *AnalyzeBotMediaRequest) (*AnalyzeBotMediaResponse, error) {
+func (c *BotClient) AnalyzeBotMedia(ctx context.Context, botId string, request *AnalyzeBotMediaRequest, opts ...AnalyzeBotMediaOptions) (*AnalyzeBotMediaResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	var opt AnalyzeBotMediaOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.APIVersion == "" {
+		opt.APIVersion = APIVersionV2Beta
+	}
+
 	path := fmt.Sprintf("bot/%s/analyze", botId)
 
 	// Make the POST request to analyze bot media
-	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, apiVersionV2Beta)
+	res, err := c.client.request(ctx, http.MethodPost, path, nil, request, opt.APIVersion)
 	if err != nil {
 		return nil, fmt.Errorf("failed to analyze bot media: %w", err)
 	}
@@ -1820,9 +2409,10 @@ func (c *BotClient) AnalyzeBotMedia(ctx context.Context, botId string, request *
 
 	// Decode the response into AnalyzeBotMediaResponse
 	var response AnalyzeBotMediaResponse
-	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+	if err := c.client.decode(res, &response); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	response.APIVersion = opt.APIVersion
 
 	return &response, nil
 }
@@ -0,0 +1,152 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// defaultBatchConcurrency is the number of workers used by the batch helpers when none is given.
+const defaultBatchConcurrency = 5
+
+// ErrBatchAborted is recorded for requests that were not sent because a fail-fast batch stopped early.
+var ErrBatchAborted = errors.New("batch aborted")
+
+// CreateBotsOptions configures the CreateBots method.
+type CreateBotsOptions struct {
+	// The maximum number of bots created at the same time. Defaults to 5.
+	Concurrency int
+	// Stop creating bots after the first failure.
+	FailFast bool
+}
+
+// CreateBotResult is the outcome of a single request in a CreateBots batch.
+type CreateBotResult struct {
+	// The index of the request in the batch.
+	Index int
+	Bot   *Bot
+	Err   error
+}
+
+// CreateBotsReport contains the outcome of every request in a CreateBots batch, in request order.
+type CreateBotsReport struct {
+	Results []CreateBotResult
+}
+
+// Succeeded returns the results of the requests that created a bot.
+func (r *CreateBotsReport) Succeeded() []CreateBotResult {
+	var results []CreateBotResult
+	for _, result := range r.Results {
+		if result.Err == nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// Failed returns the results of the requests that did not create a bot.
+func (r *CreateBotsReport) Failed() []CreateBotResult {
+	var results []CreateBotResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// CreateBots creates many bots concurrently with a bounded number of workers.
+// In fail-fast mode the first error is returned and the remaining requests are not sent;
+// otherwise every request is attempted and failures are only reported in the report.
+func (c *BotClient) CreateBots(ctx context.Context, requests []CreateBotRequest, opts ...CreateBotsOptions) (*CreateBotsReport, error) {
+	var opt CreateBotsOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Concurrency <= 0 {
+		opt.Concurrency = defaultBatchConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	report := &CreateBotsReport{Results: make([]CreateBotResult, len(requests))}
+	indexes := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+
+	for w := 0; w < opt.Concurrency && w < len(requests); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result := CreateBotResult{Index: i}
+				if ctx.Err() != nil {
+					result.Err = ErrBatchAborted
+				} else {
+					result.Bot, result.Err = c.CreateBot(ctx, &requests[i])
+				}
+				report.Results[i] = result
+
+				if result.Err != nil && opt.FailFast {
+					once.Do(func() {
+						firstErr = fmt.Errorf("failed to create bot %d: %w", i, result.Err)
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	for i := range requests {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return report, firstErr
+}
+
+// RetrieveBotResult is the outcome of a single bot in a RetrieveBots batch.
+type RetrieveBotResult struct {
+	ID  string
+	Bot *Bot
+	Err error
+}
+
+// RetrieveBots retrieves many bots concurrently with at most concurrency workers, e.g. to refresh
+// a dashboard of active bots. A non-positive concurrency defaults to 5. Every ID is attempted, and
+// the results are returned in the order of the IDs, each with its own error.
+func (c *BotClient) RetrieveBots(ctx context.Context, ids []string, concurrency int) []RetrieveBotResult {
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+
+	results := make([]RetrieveBotResult, len(ids))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < concurrency && w < len(ids); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result := RetrieveBotResult{ID: ids[i]}
+				result.Bot, result.Err = c.RetrieveBot(ctx, ids[i])
+				results[i] = result
+			}
+		}()
+	}
+
+	for i := range ids {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
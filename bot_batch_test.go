@@ -0,0 +1,111 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestCreateBots(t *testing.T) {
+	requests := []recallaigo.CreateBotRequest{
+		{MeetingURL: "", BotName: "Invalid Bot"},
+		{MeetingURL: "https://test.com", BotName: "Test Bot"},
+		{MeetingURL: "https://test.com", BotName: "Test Bot"},
+	}
+
+	tests := []struct {
+		name          string
+		opts          recallaigo.CreateBotsOptions
+		wantErr       bool
+		wantSucceeded int
+		wantFailed    int
+	}{
+		{
+			name:          "best effort",
+			opts:          recallaigo.CreateBotsOptions{Concurrency: 2},
+			wantSucceeded: 2,
+			wantFailed:    1,
+		},
+		{
+			name:       "fail fast",
+			opts:       recallaigo.CreateBotsOptions{Concurrency: 1, FailFast: true},
+			wantErr:    true,
+			wantFailed: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewMockedClient(t, "test_data/create_bot.json", http.StatusOK)
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			report, err := client.Bot.CreateBots(context.Background(), requests, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateBots() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := len(report.Succeeded()); got != tt.wantSucceeded {
+				t.Errorf("CreateBots() succeeded = %d, want %d", got, tt.wantSucceeded)
+			}
+			if got := len(report.Failed()); got != tt.wantFailed {
+				t.Errorf("CreateBots() failed = %d, want %d", got, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestRetrieveBots(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+	)
+	router := testutil.NewRouter()
+	router.Handle("GET /api/v1/bot/{id}", func(req *http.Request) *http.Response {
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		id := req.PathValue("id")
+		if strings.HasPrefix(id, "missing") {
+			return testutil.NewStringResponse(`{"detail": "Not found."}`, http.StatusNotFound)
+		}
+		return testutil.NewStringResponse(`{"id": "`+id+`"}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+	ids := []string{"a", "missing_b", "c", "d", "missing_e", "f"}
+	results := client.Bot.RetrieveBots(context.Background(), ids, 2)
+
+	if len(results) != len(ids) {
+		t.Fatalf("RetrieveBots() returned %d results, want %d", len(results), len(ids))
+	}
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("result %d ID = %q, want %q", i, result.ID, ids[i])
+		}
+		if strings.HasPrefix(ids[i], "missing") {
+			if !recallaigo.IsNotFound(result.Err) {
+				t.Errorf("result %d error = %v, want not found", i, result.Err)
+			}
+			continue
+		}
+		if result.Err != nil || result.Bot == nil || result.Bot.ID != ids[i] {
+			t.Errorf("result %d = %+v, want bot %q", i, result, ids[i])
+		}
+	}
+	if peak > 2 {
+		t.Errorf("RetrieveBots() sent %d requests at once, want at most 2", peak)
+	}
+}
@@ -0,0 +1,42 @@
+package recallaigo
+
+// CloneBotConfig extracts the configuration needed to create the same bot again.
+// The meeting URL is rebuilt for Zoom and Google Meet; for other platforms it is left empty
+// and must be set by the caller. JoinAt is not copied, so the new bot joins immediately.
+func CloneBotConfig(bot *Bot) *CreateBotRequest {
+	if bot == nil {
+		return nil
+	}
+
+	return &CreateBotRequest{
+		MeetingURL:            bot.MeetingURL.String(),
+		BotName:               bot.BotName,
+		RealTimeTranscription: clonePtr(bot.RealTimeTranscription),
+		RealTimeMedia:         clonePtr(bot.RealTimeMedia),
+		TranscriptionOptions:  clonePtr(bot.TranscriptionOptions),
+		RecordingMode:         bot.RecordingMode,
+		RecordingModeOptions:  clonePtr(bot.RecordingModeOptions),
+		IncludeBotInRecording: clonePtr(bot.IncludeBotInRecording),
+		OutputMedia:           clonePtr(bot.OutputMedia),
+		AutomaticVideoOutput:  clonePtr(bot.AutomaticVideoOutput),
+		AutomaticAudioOutput:  clonePtr(bot.AutomaticAudioOutput),
+		Chat:                  clonePtr(bot.Chat),
+		AutomaticLeave:        clonePtr(bot.AutomaticLeave),
+		BreakoutRoom:          clonePtr(bot.BreakoutRoom),
+		Variant:               clonePtr(bot.Variant),
+		Zoom:                  clonePtr(bot.Zoom),
+		GoogleMeet:            clonePtr(bot.GoogleMeet),
+		SlackAuthenticator:    clonePtr(bot.SlackAuthenticator),
+		SlackHuddleObserver:   clonePtr(bot.SlackHuddleObserver),
+		Metadata:              bot.Metadata.Clone(),
+	}
+}
+
+// clonePtr returns a pointer to a shallow copy of the value, or nil.
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+	return &c
+}
@@ -0,0 +1,43 @@
+package recallaigo_test
+
+import (
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestCloneBotConfig(t *testing.T) {
+	bot := &recallaigo.Bot{
+		ID: "bot",
+		MeetingURL: recallaigo.MeetingURL{
+			MeetingID:       "123",
+			MeetingPassword: "456",
+			Platform:        "zoom",
+		},
+		BotName:        "Test Bot",
+		JoinAt:         &recallaigo.Time{Time: time.Date(2025, 3, 18, 10, 13, 10, 0, time.UTC)},
+		RecordingMode:  recallaigo.GalleryView,
+		AutomaticLeave: &recallaigo.AutomaticLeave{EveryoneLeftTimeout: recallaigo.Duration{10 * time.Second}},
+		Metadata:       recallaigo.Metadata{"customer": "acme", "tags": []any{"vip"}},
+	}
+
+	got := recallaigo.CloneBotConfig(bot)
+
+	if got.MeetingURL != "https://zoom.us/j/123?pwd=456" {
+		t.Errorf("CloneBotConfig() meeting URL = %s", got.MeetingURL)
+	}
+	if got.BotName != bot.BotName || got.RecordingMode != bot.RecordingMode {
+		t.Errorf("CloneBotConfig() = %+v, want bot name and recording mode copied", got)
+	}
+	if got.JoinAt != nil {
+		t.Error("CloneBotConfig() copied join_at")
+	}
+
+	got.AutomaticLeave.EveryoneLeftTimeout = recallaigo.Duration{20 * time.Second}
+	got.Metadata["customer"] = "other"
+	got.Metadata["tags"].([]any)[0] = "regular"
+	if bot.AutomaticLeave.EveryoneLeftTimeout != (recallaigo.Duration{10 * time.Second}) || bot.Metadata["customer"] != "acme" || bot.Metadata["tags"].([]any)[0] != "vip" {
+		t.Error("CloneBotConfig() result shares state with the bot")
+	}
+}
@@ -0,0 +1,106 @@
+package recallaigo
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+)
+
+// ListBotsByMetadata lists the bots of every page whose metadata has all of the given string values,
+// e.g. the ID of a customer. The pairs are sent as metadata filters of the request; as the API may
+// not apply them, the bots are filtered client-side as well. params filter the bots further.
+func ListBotsByMetadata(ctx context.Context, bots BotService, metadata map[string]string, params ...ListBotsParams) ([]Bot, error) {
+	var p ListBotsParams
+	if len(params) > 0 {
+		p = params[0]
+	}
+	p.Metadata = maps.Clone(p.Metadata)
+	if p.Metadata == nil {
+		p.Metadata = make(map[string]string, len(metadata))
+	}
+	maps.Copy(p.Metadata, metadata)
+
+	var matched []Bot
+	err := forEachBot(ctx, bots, p, func(_ int, bot *Bot) error {
+		if matchesMetadata(bot.Metadata, p.Metadata) {
+			matched = append(matched, *bot)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matched, nil
+}
+
+// BotSearchHit is a bot found by SearchBotsByTitle.
+type BotSearchHit struct {
+	Bot Bot
+	// The page of ListBots the bot is on.
+	Page int
+}
+
+// SearchBotsByTitle lists the bots of every page whose meeting title contains the query,
+// ignoring case. params filter the searched bots, e.g. by JoinAtAfter; Page is ignored.
+func SearchBotsByTitle(ctx context.Context, bots BotService, query string, params ...ListBotsParams) ([]BotSearchHit, error) {
+	query = strings.ToLower(query)
+	return searchBots(ctx, bots, params, func(title string) bool {
+		return strings.Contains(strings.ToLower(title), query)
+	})
+}
+
+// SearchBotsByTitleRegexp lists the bots of every page whose meeting title matches the regular expression.
+func SearchBotsByTitleRegexp(ctx context.Context, bots BotService, re *regexp.Regexp, params ...ListBotsParams) ([]BotSearchHit, error) {
+	return searchBots(ctx, bots, params, re.MatchString)
+}
+
+func searchBots(ctx context.Context, bots BotService, params []ListBotsParams, match func(title string) bool) ([]BotSearchHit, error) {
+	var p ListBotsParams
+	if len(params) > 0 {
+		p = params[0]
+	}
+
+	var hits []BotSearchHit
+	err := forEachBot(ctx, bots, p, func(page int, bot *Bot) error {
+		if title := bot.MeetingMetadata.Title; title != "" && match(title) {
+			hits = append(hits, BotSearchHit{Bot: *bot, Page: page})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// matchesMetadata reports whether the metadata has all of the string values.
+func matchesMetadata(metadata Metadata, values map[string]string) bool {
+	for key, value := range values {
+		if v, ok := metadata.String(key); !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// forEachBot calls fn for every bot of every page of ListBots, with the number of its page,
+// stopping at the first error.
+func forEachBot(ctx context.Context, bots BotService, params ListBotsParams, fn func(page int, bot *Bot) error) error {
+	for page := 1; ; page++ {
+		params.Page = page
+		res, err := bots.ListBots(ctx, &params)
+		if err != nil {
+			return fmt.Errorf("failed to list bots: %w", err)
+		}
+		for i := range res.Results {
+			if err := fn(page, &res.Results[i]); err != nil {
+				return err
+			}
+		}
+		if res.Next == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestListBotsByMetadata(t *testing.T) {
+	var queries []string
+	router := testutil.NewRouter()
+	router.Handle("GET /api/v1/bot", func(req *http.Request) *http.Response {
+		queries = append(queries, req.URL.RawQuery)
+		if req.URL.Query().Get("page") == "2" {
+			return testutil.NewStringResponse(`{"next": null, "results": [
+				{"id": "c", "metadata": {"customer": "42", "team": "sales"}},
+				{"id": "d", "metadata": {"customer": 42, "team": "sales"}}
+			]}`, http.StatusOK)
+		}
+		return testutil.NewStringResponse(`{"next": "https://us-east-1.recall.ai/api/v1/bot/?page=2", "results": [
+			{"id": "a", "metadata": {"customer": "42", "team": "sales"}},
+			{"id": "b", "metadata": {"customer": "7", "team": "sales"}},
+			{"id": "e", "metadata": {"customer": "42"}},
+			{"id": "f"}
+		]}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+	bots, err := recallaigo.ListBotsByMetadata(context.Background(), client.Bot,
+		map[string]string{"customer": "42"},
+		recallaigo.ListBotsParams{Metadata: map[string]string{"team": "sales"}, Status: []recallaigo.Status{recallaigo.StatusDone}},
+	)
+	if err != nil {
+		t.Fatalf("ListBotsByMetadata() error = %v", err)
+	}
+
+	var ids []string
+	for _, bot := range bots {
+		ids = append(ids, bot.ID)
+	}
+	if want := []string{"a", "c"}; !slices.Equal(ids, want) {
+		t.Errorf("ListBotsByMetadata() = %v, want %v", ids, want)
+	}
+
+	want := []string{
+		"page=1&status=done&metadata__customer=42&metadata__team=sales",
+		"page=2&status=done&metadata__customer=42&metadata__team=sales",
+	}
+	if !slices.Equal(queries, want) {
+		t.Errorf("ListBotsByMetadata() queries = %v, want %v", queries, want)
+	}
+}
+
+func TestSearchBotsByTitle(t *testing.T) {
+	router := testutil.NewRouter()
+	router.Handle("GET /api/v1/bot", func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("page") == "2" {
+			return testutil.NewStringResponse(`{"next": null, "results": [
+				{"id": "c", "meeting_metadata": {"title": "Weekly sync: Sales"}},
+				{"id": "d", "meeting_metadata": {"title": "Q3 planning"}}
+			]}`, http.StatusOK)
+		}
+		return testutil.NewStringResponse(`{"next": "https://us-east-1.recall.ai/api/v1/bot/?page=2", "results": [
+			{"id": "a", "meeting_metadata": {"title": "Weekly Sync"}},
+			{"id": "b", "meeting_metadata": {"title": "1:1 Alice / Bob"}},
+			{"id": "e"}
+		]}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+	ctx := context.Background()
+
+	type hit struct {
+		id   string
+		page int
+	}
+	hits := func(found []recallaigo.BotSearchHit) []hit {
+		var got []hit
+		for _, h := range found {
+			got = append(got, hit{h.Bot.ID, h.Page})
+		}
+		return got
+	}
+
+	found, err := recallaigo.SearchBotsByTitle(ctx, client.Bot, "weekly SYNC")
+	if err != nil {
+		t.Fatalf("SearchBotsByTitle() error = %v", err)
+	}
+	if got, want := hits(found), []hit{{"a", 1}, {"c", 2}}; !slices.Equal(got, want) {
+		t.Errorf("SearchBotsByTitle() = %v, want %v", got, want)
+	}
+
+	found, err = recallaigo.SearchBotsByTitleRegexp(ctx, client.Bot, regexp.MustCompile(`^(1:1|Q\d) `))
+	if err != nil {
+		t.Fatalf("SearchBotsByTitleRegexp() error = %v", err)
+	}
+	if got, want := hits(found), []hit{{"b", 1}, {"d", 2}}; !slices.Equal(got, want) {
+		t.Errorf("SearchBotsByTitleRegexp() = %v, want %v", got, want)
+	}
+}
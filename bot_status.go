@@ -0,0 +1,181 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTerminalStatus is returned by WaitForStatus when the bot reaches a terminal status
+// other than the ones being waited for.
+var ErrTerminalStatus = errors.New("bot reached a terminal status")
+
+// defaultPollInterval is used by the polling helpers when no interval is given.
+const defaultPollInterval = 10 * time.Second
+
+// statusTransitions lists the statuses a bot can move to from each status.
+// The API may skip intermediate statuses (e.g. joining_call straight to in_call_recording),
+// so the table is intentionally permissive within the forward direction of the lifecycle.
+var statusTransitions = map[Status][]Status{
+	StatusReady: {
+		StatusJoiningCall, StatusFatal,
+	},
+	StatusJoiningCall: {
+		StatusInWaitingRoom, StatusInCallNotRecording, StatusInCallRecording, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusInWaitingRoom: {
+		StatusInCallNotRecording, StatusInCallRecording, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusInCallNotRecording: {
+		StatusRecordingPermissionAllowed, StatusRecordingPermissionDenied, StatusInCallRecording, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusRecordingPermissionAllowed: {
+		StatusInCallRecording, StatusInCallNotRecording, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusRecordingPermissionDenied: {
+		StatusRecordingPermissionAllowed, StatusInCallNotRecording, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusInCallRecording: {
+		StatusInCallNotRecording, StatusRecordingDone, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusRecordingDone: {
+		StatusInCallNotRecording, StatusInCallRecording, StatusCallEnded, StatusDone, StatusFatal,
+	},
+	StatusCallEnded: {
+		StatusRecordingDone, StatusDone, StatusFatal,
+	},
+	StatusDone: {
+		StatusMediaExpired, StatusAnalysisDone, StatusAnalysisFailed,
+	},
+	StatusAnalysisDone: {
+		StatusMediaExpired, StatusAnalysisDone, StatusAnalysisFailed,
+	},
+	StatusAnalysisFailed: {
+		StatusMediaExpired, StatusAnalysisDone, StatusAnalysisFailed,
+	},
+	StatusFatal: {
+		StatusMediaExpired,
+	},
+	StatusMediaExpired: {},
+}
+
+// IsKnown reports whether the status is one of the documented bot statuses.
+func (s Status) IsKnown() bool {
+	_, ok := statusTransitions[s]
+	return ok
+}
+
+// IsTerminal reports whether the bot has finished its lifecycle and will not rejoin or record again.
+func (s Status) IsTerminal() bool {
+	switch s {
+	case StatusDone, StatusFatal, StatusMediaExpired, StatusAnalysisDone, StatusAnalysisFailed:
+		return true
+	}
+	return false
+}
+
+// IsError reports whether the status represents a failure.
+func (s Status) IsError() bool {
+	switch s {
+	case StatusFatal, StatusAnalysisFailed:
+		return true
+	}
+	return false
+}
+
+// IsInCall reports whether the bot has been admitted to the call and has not left it yet.
+func (s Status) IsInCall() bool {
+	switch s {
+	case StatusInCallNotRecording, StatusRecordingPermissionAllowed, StatusRecordingPermissionDenied,
+		StatusInCallRecording, StatusRecordingDone:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo reports whether a bot in status s can move to next.
+// Unknown statuses never transition.
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateStatusTransition returns an error if a bot cannot move from one status to the other.
+func ValidateStatusTransition(from, to Status) error {
+	if !from.IsKnown() {
+		return fmt.Errorf("unknown status: %s", from)
+	}
+	if !to.IsKnown() {
+		return fmt.Errorf("unknown status: %s", to)
+	}
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("invalid status transition: %s -> %s", from, to)
+	}
+	return nil
+}
+
+// LatestStatusChange returns the most recent status change of the bot, or nil if there is none.
+func (b *Bot) LatestStatusChange() *StatusChange {
+	if len(b.StatusChanges) == 0 {
+		return nil
+	}
+	return &b.StatusChanges[len(b.StatusChanges)-1]
+}
+
+// CurrentStatus returns the status of the most recent status change.
+// It returns an empty Status if the bot has no status changes yet.
+func (b *Bot) CurrentStatus() Status {
+	change := b.LatestStatusChange()
+	if change == nil {
+		return ""
+	}
+	return change.Code
+}
+
+// isScheduled reports whether the bot is scheduled to join its call later: it has a join time
+// and has not started joining, i.e. it has no status yet or is ready. Once the bot joins, the
+// API still reports its join time, so it cannot tell scheduled bots apart on its own.
+func (b *Bot) isScheduled() bool {
+	status := b.CurrentStatus()
+	return b.JoinAt != nil && (status == "" || status == StatusReady)
+}
+
+// WaitForStatus polls the bot until its current status is one of the given statuses.
+// If the bot reaches a terminal status that is not being waited for, the bot is returned
+// together with ErrTerminalStatus. A non-positive interval defaults to 10 seconds.
+func (c *BotClient) WaitForStatus(ctx context.Context, botID string, interval time.Duration, statuses ...Status) (*Bot, error) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	ticker := c.client.clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		bot, err := c.RetrieveBot(ctx, botID)
+		if err != nil {
+			return nil, err
+		}
+
+		current := bot.CurrentStatus()
+		for _, status := range statuses {
+			if current == status {
+				return bot, nil
+			}
+		}
+		if current.IsTerminal() {
+			return bot, fmt.Errorf("%w: %s", ErrTerminalStatus, current)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestStatus(t *testing.T) {
+	t.Run("IsTerminal", func(t *testing.T) {
+		tests := []struct {
+			status recallaigo.Status
+			want   bool
+		}{
+			{status: recallaigo.StatusJoiningCall, want: false},
+			{status: recallaigo.StatusInCallRecording, want: false},
+			{status: recallaigo.StatusCallEnded, want: false},
+			{status: recallaigo.StatusDone, want: true},
+			{status: recallaigo.StatusFatal, want: true},
+			{status: recallaigo.StatusMediaExpired, want: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.status.String(), func(t *testing.T) {
+				if got := tt.status.IsTerminal(); got != tt.want {
+					t.Errorf("IsTerminal() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("ValidateStatusTransition", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			from    recallaigo.Status
+			to      recallaigo.Status
+			wantErr bool
+		}{
+			{
+				name: "joining to recording",
+				from: recallaigo.StatusJoiningCall,
+				to:   recallaigo.StatusInCallRecording,
+			},
+			{
+				name: "recording to fatal",
+				from: recallaigo.StatusInCallRecording,
+				to:   recallaigo.StatusFatal,
+			},
+			{
+				name:    "done to joining",
+				from:    recallaigo.StatusDone,
+				to:      recallaigo.StatusJoiningCall,
+				wantErr: true,
+			},
+			{
+				name:    "unknown status",
+				from:    recallaigo.Status("unknown"),
+				to:      recallaigo.StatusDone,
+				wantErr: true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := recallaigo.ValidateStatusTransition(tt.from, tt.to)
+				if (err != nil) != tt.wantErr {
+					t.Errorf("ValidateStatusTransition() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+}
+
+func TestBotCurrentStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		bot  recallaigo.Bot
+		want recallaigo.Status
+	}{
+		{
+			name: "no status changes",
+			bot:  recallaigo.Bot{},
+			want: "",
+		},
+		{
+			name: "returns latest status",
+			bot: recallaigo.Bot{
+				StatusChanges: []recallaigo.StatusChange{
+					{Code: "joining_call"},
+					{Code: "in_call_recording"},
+				},
+			},
+			want: recallaigo.StatusInCallRecording,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bot.CurrentStatus(); got != tt.want {
+				t.Errorf("CurrentStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,10 +2,14 @@ package recallaigo_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
 )
 
 func TestBotClient(t *testing.T) {
@@ -33,7 +37,7 @@ func TestBotClient(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				c := newMockedClient(t, tt.filePath, tt.statusCode)
+				c := testutil.NewMockedClient(t, tt.filePath, tt.statusCode)
 				client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
 				got, err := client.Bot.ListBots(context.Background(), nil)
 
@@ -75,7 +79,7 @@ func TestBotClient(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				c := newMockedClient(t, tt.filePath, tt.statusCode)
+				c := testutil.NewMockedClient(t, tt.filePath, tt.statusCode)
 
 				request := recallaigo.CreateBotRequest{
 					MeetingURL:    "https://test.com",
@@ -104,3 +108,511 @@ func TestBotClient(t *testing.T) {
 	})
 
 }
+
+func TestGetBotTranscriptAfter(t *testing.T) {
+	body := `[
+		{"speaker": "Alice", "speaker_id": 1, "words": [{"text": "Hello", "start_timestamp": 1}, {"text": "there", "start_timestamp": 2}]},
+		{"speaker": "Bob", "speaker_id": 2, "words": [{"text": "Hi", "start_timestamp": 3}]}
+	]`
+
+	tests := []struct {
+		name      string
+		after     float64
+		wantWords []int
+	}{
+		{name: "returns everything", after: 0, wantWords: []int{2, 1}},
+		{name: "slices partial entries", after: 1, wantWords: []int{1, 1}},
+		{name: "drops seen entries", after: 2, wantWords: []int{1}},
+		{name: "returns nothing new", after: 3, wantWords: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(*http.Request) *http.Response {
+				return testutil.NewStringResponse(body, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			got, err := client.Bot.GetBotTranscript(context.Background(), "bot_id", recallaigo.GetBotTranscriptParams{After: tt.after})
+			if err != nil {
+				t.Fatalf("GetBotTranscript() error = %v", err)
+			}
+			if len(got) != len(tt.wantWords) {
+				t.Fatalf("GetBotTranscript() returned %d entries, want %d", len(got), len(tt.wantWords))
+			}
+			for i, entry := range got {
+				if len(entry.Words) != tt.wantWords[i] {
+					t.Errorf("GetBotTranscript()[%d] has %d words, want %d", i, len(entry.Words), tt.wantWords[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStreamBotTranscript(t *testing.T) {
+	errStop := errors.New("stop")
+	body := `[{"speaker": "Alice", "words": [{"text": "Hello"}]}, {"speaker": "Bob", "words": [{"text": "Hi"}]}]`
+
+	tests := []struct {
+		name      string
+		body      string
+		stopAfter int
+		wantCount int
+		wantErr   bool
+		wantErrIs error
+	}{
+		{
+			name:      "yields every entry",
+			body:      body,
+			wantCount: 2,
+		},
+		{
+			name:      "stops when the callback fails",
+			body:      body,
+			stopAfter: 1,
+			wantCount: 1,
+			wantErr:   true,
+			wantErrIs: errStop,
+		},
+		{
+			name:      "fails on truncated body",
+			body:      body[:len(body)-20],
+			wantCount: 1,
+			wantErr:   true,
+		},
+		{
+			name:    "fails on non-array body",
+			body:    `{"detail": "not an array"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(*http.Request) *http.Response {
+				return testutil.NewStringResponse(tt.body, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			var count int
+			err := client.Bot.StreamBotTranscript(context.Background(), "bot_id", func(recallaigo.TranscriptEntry) error {
+				count++
+				if count == tt.stopAfter {
+					return errStop
+				}
+				return nil
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StreamBotTranscript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("StreamBotTranscript() error = %v, want %v", err, tt.wantErrIs)
+			}
+			if count != tt.wantCount {
+				t.Errorf("StreamBotTranscript() yielded %d entries, want %d", count, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestTranscriptEntryRaw(t *testing.T) {
+	c := testutil.NewTestClient(func(*http.Request) *http.Response {
+		return testutil.NewStringResponse(`[{"speaker": "Alice", "words": [{"text": "Great"}], "sentiment": "positive"}]`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithRawTranscripts(true))
+
+	got, err := client.Bot.GetBotTranscript(context.Background(), "bot_id")
+	if err != nil {
+		t.Fatalf("GetBotTranscript() error = %v", err)
+	}
+
+	var raw struct {
+		Sentiment string `json:"sentiment"`
+	}
+	if err := json.Unmarshal(got[0].Raw, &raw); err != nil {
+		t.Fatalf("failed to decode raw entry: %v", err)
+	}
+	if raw.Sentiment != "positive" {
+		t.Errorf("Raw sentiment = %q, want %q", raw.Sentiment, "positive")
+	}
+	if got[0].Speaker != "Alice" || len(got[0].Words) != 1 {
+		t.Errorf("GetBotTranscript() = %+v, want the normalized entry", got[0])
+	}
+
+	client = recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+	got, err = client.Bot.GetBotTranscript(context.Background(), "bot_id")
+	if err != nil {
+		t.Fatalf("GetBotTranscript() error = %v", err)
+	}
+	if got[0].Raw != nil {
+		t.Errorf("Raw = %s, want nil unless WithRawTranscripts is used", got[0].Raw)
+	}
+}
+
+func TestScheduleBot(t *testing.T) {
+	var body map[string]any
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	joinAt := time.Date(2025, 3, 18, 12, 13, 10, 433000000, time.FixedZone("CEST", 2*60*60))
+	bot, err := client.Bot.CreateBot(context.Background(), &recallaigo.CreateBotRequest{
+		MeetingURL: "https://zoom.us/j/123",
+		BotName:    "Test Bot",
+		JoinAt:     recallaigo.ScheduleAt(joinAt),
+	})
+	if err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+
+	if body["join_at"] != "2025-03-18T10:13:10.433Z" {
+		t.Errorf("CreateBot() sent join_at = %v, want %q", body["join_at"], "2025-03-18T10:13:10.433Z")
+	}
+	if bot.JoinAt == nil || !bot.JoinAt.Equal(joinAt) {
+		t.Errorf("CreateBot() join_at = %v, want %v", bot.JoinAt, joinAt)
+	}
+
+	if in := time.Until(*recallaigo.ScheduleIn(time.Hour)); in < 59*time.Minute || in > time.Hour {
+		t.Errorf("ScheduleIn() is %v from now, want 1h", in)
+	}
+}
+
+func TestGoogleMeetValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		googleMeet recallaigo.GoogleMeet
+		wantErr    bool
+	}{
+		{name: "anonymous", googleMeet: recallaigo.GoogleMeet{}},
+		{name: "login required with group", googleMeet: recallaigo.GoogleMeet{LoginRequired: true, GoogleLoginGroupID: "group"}},
+		{
+			name: "specific login",
+			googleMeet: recallaigo.GoogleMeet{
+				GoogleLoginGroupID: "group",
+				LoginMode:          recallaigo.GoogleMeetLoginModeOnlyIfRequired,
+				GoogleLoginID:      "login",
+			},
+		},
+		{name: "login required without group", googleMeet: recallaigo.GoogleMeet{LoginRequired: true}, wantErr: true},
+		{name: "login mode without group", googleMeet: recallaigo.GoogleMeet{LoginMode: recallaigo.GoogleMeetLoginModeAlways}, wantErr: true},
+		{name: "login without group", googleMeet: recallaigo.GoogleMeet{GoogleLoginID: "login"}, wantErr: true},
+		{name: "unknown login mode", googleMeet: recallaigo.GoogleMeet{GoogleLoginGroupID: "group", LoginMode: "sometimes"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &recallaigo.CreateBotRequest{
+				MeetingURL: "https://meet.google.com/abc-defg-hij",
+				BotName:    "Test Bot",
+				GoogleMeet: &tt.googleMeet,
+			}
+			if err := request.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBreakoutRoomValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		breakoutRoom recallaigo.BreakoutRoom
+		wantErr      bool
+	}{
+		{name: "main room", breakoutRoom: recallaigo.BreakoutRoom{Mode: recallaigo.BreakoutRoomJoinMainRoom}},
+		{name: "all invites", breakoutRoom: recallaigo.BreakoutRoom{Mode: recallaigo.BreakoutRoomAutoAcceptAllInvites}},
+		{name: "specific room", breakoutRoom: recallaigo.BreakoutRoom{Mode: recallaigo.BreakoutRoomJoinSpecificRoom, RoomName: "Room 1"}},
+		{name: "specific room without name", breakoutRoom: recallaigo.BreakoutRoom{Mode: recallaigo.BreakoutRoomJoinSpecificRoom}, wantErr: true},
+		{name: "no mode", breakoutRoom: recallaigo.BreakoutRoom{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &recallaigo.CreateBotRequest{
+				MeetingURL:   "https://zoom.us/j/123",
+				BotName:      "Test Bot",
+				BreakoutRoom: &tt.breakoutRoom,
+			}
+			if err := request.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpeakerTimelineEntryTimes(t *testing.T) {
+	start := time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		timestamp  float64
+		wantOffset time.Duration
+	}{
+		{0, 0},
+		{0.3, 300 * time.Millisecond},
+		{61.5, time.Minute + 1500*time.Millisecond},
+		{3600.123, time.Hour + 123*time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		entry := recallaigo.SpeakerTimelineEntry{Name: "Alice", Timestamp: tt.timestamp}
+		if got := entry.Offset(); got != tt.wantOffset {
+			t.Errorf("Offset() of %v = %v, want %v", tt.timestamp, got, tt.wantOffset)
+		}
+		if got, want := entry.At(start), start.Add(tt.wantOffset); !got.Equal(want) {
+			t.Errorf("At() of %v = %v, want %v", tt.timestamp, got, want)
+		}
+	}
+}
+
+func TestBotRecordings(t *testing.T) {
+	c := testutil.NewMockedClient(t, "test_data/retrieve_bot.json", http.StatusOK)
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	bot, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+	if err != nil {
+		t.Fatalf("RetrieveBot() error = %v", err)
+	}
+
+	recording := bot.LatestRecording()
+	if recording == nil {
+		t.Fatal("LatestRecording() = nil, want recording")
+	}
+	if recording.Status == nil || recording.Status.Code != "done" {
+		t.Errorf("Recording.Status = %+v, want done", recording.Status)
+	}
+	if want := time.Date(2025, 3, 25, 10, 13, 10, 433000000, time.UTC); !recording.ExpiresAt.Equal(want) {
+		t.Errorf("Recording.ExpiresAt = %v, want %v", recording.ExpiresAt, want)
+	}
+
+	shortcuts := recording.MediaShortcuts
+	if shortcuts == nil || shortcuts.VideoMixed == nil || shortcuts.Transcript == nil {
+		t.Fatalf("Recording.MediaShortcuts = %+v, want video_mixed and transcript", shortcuts)
+	}
+	if shortcuts.AudioMixed != nil {
+		t.Errorf("MediaShortcuts.AudioMixed = %+v, want nil", shortcuts.AudioMixed)
+	}
+	if got := shortcuts.VideoMixed.Data.DownloadURL; got != "https://media.test/video.mp4" {
+		t.Errorf("VideoMixed.Data.DownloadURL = %q, want %q", got, "https://media.test/video.mp4")
+	}
+	if got := shortcuts.Transcript.Data.ProviderDataDownloadURL; got != "https://media.test/transcript_provider.json" {
+		t.Errorf("Transcript.Data.ProviderDataDownloadURL = %q, want %q", got, "https://media.test/transcript_provider.json")
+	}
+
+	if (&recallaigo.Bot{}).LatestRecording() != nil {
+		t.Error("LatestRecording() of a bot without recordings is not nil")
+	}
+}
+
+func TestAnalyzeBotMedia(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  recallaigo.AnalyzeBotMediaRequest
+		opts     recallaigo.AnalyzeBotMediaOptions
+		wantPath string
+		wantKeys []string
+		wantErr  bool
+	}{
+		{
+			name: "sends only the selected provider",
+			request: recallaigo.AnalyzeBotMediaRequest{
+				DeepgramAsyncTranscription: &recallaigo.DeepgramAsyncTranscription{},
+			},
+			wantPath: "/api/v2beta/bot/some_id/analyze",
+			wantKeys: []string{"deepgram_async_transcription"},
+		},
+		{
+			name: "uses the selected API version",
+			request: recallaigo.AnalyzeBotMediaRequest{
+				DeepgramAsyncTranscription: &recallaigo.DeepgramAsyncTranscription{},
+			},
+			opts:     recallaigo.AnalyzeBotMediaOptions{APIVersion: recallaigo.APIVersionV1},
+			wantPath: "/api/v1/bot/some_id/analyze",
+			wantKeys: []string{"deepgram_async_transcription"},
+		},
+		{
+			name: "sends Gladia V2",
+			request: recallaigo.AnalyzeBotMediaRequest{
+				GladiaV2AsyncTranscription: &recallaigo.GladiaV2AsyncTranscription{
+					DiarizationConfig: &recallaigo.DiarizationConfig{MaxSpeakers: 4},
+				},
+			},
+			wantKeys: []string{"gladia_v2_async_transcription"},
+		},
+		{
+			name: "rejects Gladia together with Gladia V2",
+			request: recallaigo.AnalyzeBotMediaRequest{
+				GladiaAsyncTranscription:   &recallaigo.GladiaAsyncTranscription{},
+				GladiaV2AsyncTranscription: &recallaigo.GladiaV2AsyncTranscription{},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "requires a provider",
+			wantErr: true,
+		},
+		{
+			name: "rejects several providers",
+			request: recallaigo.AnalyzeBotMediaRequest{
+				DeepgramAsyncTranscription: &recallaigo.DeepgramAsyncTranscription{},
+				RevAsyncTranscription:      &recallaigo.RevAsyncTranscription{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				body map[string]json.RawMessage
+				path string
+			)
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				path = req.URL.Path
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+				return testutil.NewStringResponse(`{"job_id": "123"}`, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			res, err := client.Bot.AnalyzeBotMedia(context.Background(), "some_id", &tt.request, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AnalyzeBotMedia() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if res.JobId != "123" {
+				t.Errorf("AnalyzeBotMedia() job id = %q, want %q", res.JobId, "123")
+			}
+			if tt.wantPath != "" && path != tt.wantPath {
+				t.Errorf("AnalyzeBotMedia() path = %q, want %q", path, tt.wantPath)
+			}
+			wantVersion := tt.opts.APIVersion
+			if wantVersion == "" {
+				wantVersion = recallaigo.APIVersionV2Beta
+			}
+			if res.APIVersion != wantVersion {
+				t.Errorf("AnalyzeBotMedia() API version = %q, want %q", res.APIVersion, wantVersion)
+			}
+			if len(body) != len(tt.wantKeys) {
+				t.Errorf("AnalyzeBotMedia() sent %d providers, want %v", len(body), tt.wantKeys)
+			}
+			for _, key := range tt.wantKeys {
+				if _, ok := body[key]; !ok {
+					t.Errorf("AnalyzeBotMedia() did not send %s", key)
+				}
+			}
+		})
+	}
+}
+
+func TestAssemblyAIAsyncTranscriptionJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		req  recallaigo.AssemblyAIAsyncTranscription
+		want string
+	}{
+		{
+			name: "omits unset options",
+			want: `{}`,
+		},
+		{
+			name: "sends set options",
+			req: recallaigo.AssemblyAIAsyncTranscription{
+				SpeakerLabels:     true,
+				RedactPII:         true,
+				RedactPIIPolicies: []string{"email_address"},
+				WordBoost:         []string{"Recall"},
+				Punctuate:         recallaigo.Ptr(false),
+			},
+			want: `{"punctuate":false,"redact_pii":true,"redact_pii_policies":["email_address"],"speaker_labels":true,"word_boost":["Recall"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.req)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeBotMediaRequestSetCredentialID(t *testing.T) {
+	request := recallaigo.AnalyzeBotMediaRequest{
+		SpeechmaticsAsyncTranscription: &recallaigo.SpeechmaticsAsyncTranscription{Language: "en"},
+	}
+	if err := request.SetCredentialID("cred_123"); err != nil {
+		t.Fatalf("SetCredentialID() error = %v", err)
+	}
+	if got := request.SpeechmaticsAsyncTranscription.CredentialID; got != "cred_123" {
+		t.Errorf("SetCredentialID() credential = %q, want %q", got, "cred_123")
+	}
+
+	if err := (&recallaigo.AnalyzeBotMediaRequest{}).SetCredentialID("cred_123"); err == nil {
+		t.Error("SetCredentialID() without provider error = nil, want error")
+	}
+}
+
+func TestListBotsQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *recallaigo.ListBotsParams
+		want   string
+	}{
+		{
+			name: "without params",
+			want: "",
+		},
+		{
+			name:   "without filters",
+			params: &recallaigo.ListBotsParams{},
+			want:   "",
+		},
+		{
+			name: "with filters",
+			params: &recallaigo.ListBotsParams{
+				JoinAtAfter: "2025-03-18T10:00:00Z",
+				MeetingURL:  "https://zoom.us/j/123?pwd=456",
+				Page:        2,
+				Platform:    []recallaigo.Platform{recallaigo.PlatformZoom, recallaigo.PlatformGoogleMeet},
+				Status:      []recallaigo.Status{recallaigo.StatusDone},
+			},
+			want: "join_at_after=2025-03-18T10%3A00%3A00Z&meeting_url=https%3A%2F%2Fzoom.us%2Fj%2F123%3Fpwd%3D456&page=2&platform=zoom&platform=google_meet&status=done",
+		},
+		{
+			name:   "with metadata",
+			params: &recallaigo.ListBotsParams{Metadata: map[string]string{"team": "sales", "customer": "c 1"}},
+			want:   "metadata__customer=c+1&metadata__team=sales",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				got = req.URL.RawQuery
+				return testutil.NewFileResponse(t, "test_data/list_bots.json", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			if _, err := client.Bot.ListBots(context.Background(), tt.params); err != nil {
+				t.Fatalf("ListBots() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("query = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package recallaigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// maxPooledBufferSize is the capacity above which request buffers are left to the garbage collector
+// instead of being pooled, so that a single huge payload does not stay in memory.
+const maxPooledBufferSize = 16 << 20
+
+// bufferPool holds the buffers of request bodies, which can be several megabytes for the
+// base64-encoded media of OutputAudio and OutputVideo.
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// pooledBody is a JSON request body in a pooled buffer. The buffer returns to the pool once
+// the caller released the body and the transport closed every reader of it, including
+// the ones of retries.
+type pooledBody struct {
+	buf  *bytes.Buffer
+	refs atomic.Int32
+}
+
+// newPooledBody encodes v as JSON into a pooled buffer. The caller must call release once the
+// request has been sent.
+func newPooledBody(v any) (*pooledBody, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	// Encode like json.Marshal, without the trailing newline of the encoder.
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		putBuffer(buf)
+		return nil, err
+	}
+	buf.Truncate(buf.Len() - 1)
+
+	body := &pooledBody{buf: buf}
+	body.refs.Store(1)
+	return body, nil
+}
+
+// attach sets the body of the request, allowing it to be rewound for retries.
+func (b *pooledBody) attach(req *http.Request) {
+	req.ContentLength = int64(b.buf.Len())
+	req.Body = b.reader()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return b.reader(), nil
+	}
+}
+
+func (b *pooledBody) reader() io.ReadCloser {
+	b.refs.Add(1)
+	return &pooledBodyReader{Reader: bytes.NewReader(b.buf.Bytes()), body: b}
+}
+
+func (b *pooledBody) release() {
+	if b.refs.Add(-1) == 0 {
+		putBuffer(b.buf)
+	}
+}
+
+type pooledBodyReader struct {
+	*bytes.Reader
+	body   *pooledBody
+	closed atomic.Bool
+}
+
+func (r *pooledBodyReader) Close() error {
+	if r.closed.CompareAndSwap(false, true) {
+		r.body.release()
+	}
+	return nil
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBufferSize {
+		bufferPool.Put(buf)
+	}
+}
@@ -0,0 +1,80 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestRequestBodyPooling(t *testing.T) {
+	request := &recallaigo.OutputAudioRequest{
+		Kind:    recallaigo.OutputAudioKindMp3,
+		B64Data: base64.StdEncoding.EncodeToString([]byte(strings.Repeat("audio", 1<<16))),
+	}
+	want, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bodies []string
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Body.Close()
+		bodies = append(bodies, string(body))
+
+		// Rate limit every first attempt, so that each call also sends a rewound body.
+		if len(bodies)%2 == 1 {
+			return testutil.NewStringResponse(`{"detail": "Too many requests."}`, http.StatusTooManyRequests)
+		}
+		return testutil.NewFileResponse(t, "test_data/output_audio.json", http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token",
+		recallaigo.WithHTTPClient(c),
+		recallaigo.WithRetry(recallaigo.RetryPolicy{MaxRetries: 1, Backoff: recallaigo.NoBackoff}),
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Bot.OutputAudio(context.Background(), "some_id", request); err != nil {
+			t.Fatalf("OutputAudio() error = %v", err)
+		}
+	}
+
+	if len(bodies) != 6 {
+		t.Fatalf("sent %d requests, want 6", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != string(want) {
+			t.Errorf("body of request %d differs from json.Marshal of the request", i)
+		}
+	}
+}
+
+func BenchmarkOutputAudio(b *testing.B) {
+	request := &recallaigo.OutputAudioRequest{
+		Kind:    recallaigo.OutputAudioKindMp3,
+		B64Data: base64.StdEncoding.EncodeToString(make([]byte, 1<<20)),
+	}
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+		return testutil.NewStringResponse(`{"id": "some_id"}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.Bot.OutputAudio(context.Background(), "some_id", request); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
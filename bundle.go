@@ -0,0 +1,158 @@
+package recallaigo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Keys of the files written by ExportMeetingBundle.
+const (
+	BundleKeyManifest        = "manifest.json"
+	BundleKeyBot             = "bot.json"
+	BundleKeyParticipants    = "participants.json"
+	BundleKeyAttendance      = "attendance.csv"
+	BundleKeyTranscript      = "transcript.json"
+	BundleKeySpeakerTimeline = "speaker_timeline.json"
+	BundleKeyChat            = "chat.json"
+	BundleKeyVideo           = "video.mp4"
+	BundleKeyScreenshots     = "screenshots/"
+)
+
+// MeetingBundleOptions configures the ExportMeetingBundle method.
+type MeetingBundleOptions struct {
+	// Also export the video recording and the screenshots of the bot.
+	IncludeMedia bool
+}
+
+// MeetingBundleManifest describes the content of a meeting bundle. It is written last as manifest.json.
+type MeetingBundleManifest struct {
+	BotID      string    `json:"bot_id"`
+	ExportedAt time.Time `json:"exported_at"`
+	// The keys of the files in the bundle.
+	Files []string `json:"files"`
+	// The parts that could not be exported, e.g. the transcript of a bot without transcription.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ExportMeetingBundle writes the bot metadata, participants, transcript, speaker timeline, chat and
+// optionally the media of a bot into the sink, for archival or hand-off to other systems.
+// Use a DirSink to write a directory, or a TarSink to write a tar stream.
+//
+// Only a failure to retrieve the bot aborts the export. Parts that fail afterwards are recorded in
+// the manifest and returned as joined errors together with the manifest.
+func (c *MediaClient) ExportMeetingBundle(ctx context.Context, botID string, sink BlobSink, opts ...MeetingBundleOptions) (*MeetingBundleManifest, error) {
+	var opt MeetingBundleOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	bot, err := c.client.Bot.RetrieveBot(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &MeetingBundleManifest{
+		BotID:      botID,
+		ExportedAt: c.client.clock.Now().UTC(),
+	}
+	var errs []error
+	add := func(key string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to export %s: %w", key, err))
+			manifest.Errors = append(manifest.Errors, fmt.Sprintf("%s: %v", key, err))
+			return
+		}
+		manifest.Files = append(manifest.Files, key)
+	}
+
+	add(BundleKeyBot, putJSON(ctx, sink, BundleKeyBot, bot))
+	add(BundleKeyParticipants, putJSON(ctx, sink, BundleKeyParticipants, bot.MeetingParticipants))
+
+	var attendance bytes.Buffer
+	if err := WriteAttendanceCSV(&attendance, Attendance(bot.MeetingParticipants, time.Time{})); err != nil {
+		add(BundleKeyAttendance, err)
+	} else {
+		add(BundleKeyAttendance, sink.Put(ctx, BundleKeyAttendance, &attendance))
+	}
+
+	if transcript, err := c.client.Bot.GetBotTranscript(ctx, botID); err != nil {
+		add(BundleKeyTranscript, err)
+	} else {
+		add(BundleKeyTranscript, putJSON(ctx, sink, BundleKeyTranscript, transcript))
+	}
+
+	if timeline, err := c.client.Bot.GetSpeakerTimeline(ctx, botID); err != nil {
+		add(BundleKeySpeakerTimeline, err)
+	} else {
+		add(BundleKeySpeakerTimeline, putJSON(ctx, sink, BundleKeySpeakerTimeline, timeline))
+	}
+
+	if messages, err := c.listAllChatMessages(ctx, botID); err != nil {
+		add(BundleKeyChat, err)
+	} else {
+		add(BundleKeyChat, putJSON(ctx, sink, BundleKeyChat, messages))
+	}
+
+	if opt.IncludeMedia {
+		if bot.VideoURL != "" {
+			add(BundleKeyVideo, c.DownloadToSink(ctx, bot.VideoURL, sink, BundleKeyVideo))
+		}
+
+		names, err := c.DownloadScreenshots(ctx, botID, prefixSink{sink: sink, prefix: BundleKeyScreenshots})
+		for _, name := range names {
+			add(BundleKeyScreenshots+name, nil)
+		}
+		if err != nil {
+			add(BundleKeyScreenshots, err)
+		}
+	}
+
+	if err := putJSON(ctx, sink, BundleKeyManifest, manifest); err != nil {
+		errs = append(errs, fmt.Errorf("failed to export %s: %w", BundleKeyManifest, err))
+	}
+
+	return manifest, errors.Join(errs...)
+}
+
+func (c *MediaClient) listAllChatMessages(ctx context.Context, botID string) ([]Message, error) {
+	var (
+		messages []Message
+		params   ListChatMessagesParams
+	)
+	for {
+		page, err := c.client.Bot.ListChatMessages(ctx, botID, params)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, page.Results...)
+
+		params.Cursor = cursorFromURL(page.Next)
+		if params.Cursor == "" {
+			return messages, nil
+		}
+	}
+}
+
+// putJSON stores v as indented JSON under key.
+func putJSON(ctx context.Context, sink BlobSink, key string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	return sink.Put(ctx, key, bytes.NewReader(data))
+}
+
+// prefixSink is a BlobSink storing every blob under a key prefix of another sink.
+type prefixSink struct {
+	sink   BlobSink
+	prefix string
+}
+
+func (s prefixSink) Put(ctx context.Context, key string, r io.Reader) error {
+	return s.sink.Put(ctx, s.prefix+key, r)
+}
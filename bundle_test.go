@@ -0,0 +1,126 @@
+package recallaigo_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func newBundleClient(t *testing.T, transcriptStatus int) *recallaigo.Client {
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		switch {
+		case req.URL.Host == "media.test":
+			return testutil.NewStringResponse(req.URL.Path, http.StatusOK)
+		case strings.HasSuffix(req.URL.Path, "/transcript"):
+			if transcriptStatus != http.StatusOK {
+				return testutil.NewFileResponse(t, "test_data/error.json", transcriptStatus)
+			}
+			return testutil.NewFileResponse(t, "test_data/get_bot_transcript.json", http.StatusOK)
+		case strings.HasSuffix(req.URL.Path, "/speaker_timeline"):
+			return testutil.NewFileResponse(t, "test_data/get_speaker_timeline.json", http.StatusOK)
+		case strings.HasSuffix(req.URL.Path, "/chat-messages"):
+			return testutil.NewFileResponse(t, "test_data/list_chat_messages.json", http.StatusOK)
+		case strings.HasSuffix(req.URL.Path, "/screenshots"):
+			return testutil.NewFileResponse(t, "test_data/list_bot_screenshots.json", http.StatusOK)
+		default:
+			return testutil.NewStringResponse(`{"id": "bot", "video_url": "https://media.test/video.mp4"}`, http.StatusOK)
+		}
+	})
+	return recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+}
+
+func TestExportMeetingBundle(t *testing.T) {
+	t.Run("writes directory", func(t *testing.T) {
+		client := newBundleClient(t, http.StatusOK)
+		dir := t.TempDir()
+
+		manifest, err := client.Media.ExportMeetingBundle(context.Background(), "bot", recallaigo.DirSink{Dir: dir})
+		if err != nil {
+			t.Fatalf("ExportMeetingBundle() error = %v", err)
+		}
+
+		want := []string{"bot.json", "participants.json", "attendance.csv", "transcript.json", "speaker_timeline.json", "chat.json"}
+		if !slices.Equal(manifest.Files, want) {
+			t.Errorf("ExportMeetingBundle() files = %v, want %v", manifest.Files, want)
+		}
+		for _, key := range append(want, "manifest.json") {
+			if _, err := os.Stat(filepath.Join(dir, key)); err != nil {
+				t.Errorf("ExportMeetingBundle() did not write %s: %v", key, err)
+			}
+		}
+	})
+
+	t.Run("records failed parts", func(t *testing.T) {
+		client := newBundleClient(t, http.StatusNotFound)
+		dir := t.TempDir()
+
+		manifest, err := client.Media.ExportMeetingBundle(context.Background(), "bot", recallaigo.DirSink{Dir: dir})
+		if err == nil {
+			t.Fatal("ExportMeetingBundle() error = nil, want transcript error")
+		}
+		if len(manifest.Errors) != 1 || !strings.HasPrefix(manifest.Errors[0], "transcript.json:") {
+			t.Errorf("ExportMeetingBundle() errors = %v, want the transcript error", manifest.Errors)
+		}
+		if slices.Contains(manifest.Files, "transcript.json") {
+			t.Error("ExportMeetingBundle() listed the failed transcript")
+		}
+	})
+
+	t.Run("writes tar stream with media", func(t *testing.T) {
+		client := newBundleClient(t, http.StatusOK)
+		var buf bytes.Buffer
+		sink := recallaigo.NewTarSink(&buf)
+
+		_, err := client.Media.ExportMeetingBundle(context.Background(), "bot", sink, recallaigo.MeetingBundleOptions{IncludeMedia: true})
+		if err != nil {
+			t.Fatalf("ExportMeetingBundle() error = %v", err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+
+		files := map[string]string{}
+		tr := tar.NewReader(&buf)
+		for {
+			header, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			files[header.Name] = string(content)
+		}
+
+		if files["video.mp4"] != "/video.mp4" {
+			t.Errorf("tar video.mp4 = %q, want %q", files["video.mp4"], "/video.mp4")
+		}
+		screenshots := 0
+		for name := range files {
+			if strings.HasPrefix(name, "screenshots/") {
+				screenshots++
+			}
+		}
+		if screenshots != 2 {
+			t.Errorf("tar has %d screenshots, want 2", screenshots)
+		}
+		if _, ok := files["manifest.json"]; !ok {
+			t.Error("tar has no manifest.json")
+		}
+	})
+}
@@ -0,0 +1,250 @@
+package recallaigo
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStore stores cached responses by key. Implementations must be safe for concurrent use,
+// and may share their entries between clients and processes, e.g. in Redis.
+type CacheStore interface {
+	// Get returns the value of the key, and whether it was found.
+	Get(key string) ([]byte, bool)
+	// Set stores the value of the key. The store may evict it at any time.
+	Set(key string, value []byte)
+	// Delete removes the key. Deleting a missing key is a no-op.
+	Delete(key string)
+}
+
+// WithCache caches the responses that can no longer change in the store: the transcripts and
+// speaker timelines of bots in a terminal status, such as done. A bot is known to be terminal
+// once it was returned by RetrieveBot or ListBots of a client using the same store; until then
+// its responses are fetched as usual. Async transcripts, which change when the bot is analyzed
+// again, are never cached. DeleteBotMedia and DeleteScheduledBot evict the responses of the bot.
+func WithCache(store CacheStore) ClientOption {
+	return func(c *Client) {
+		c.cache = store
+	}
+}
+
+// terminalMarker is the value stored for bots known to be in a terminal status.
+var terminalMarker = []byte("terminal")
+
+// remember records what the caching and region guard of the client know from a bot returned by the API.
+func (c *Client) remember(bot *Bot) {
+	c.rememberTerminal(bot)
+	c.rememberRegion(bot)
+}
+
+// rememberTerminal marks the bot in the cache if its status is terminal.
+func (c *Client) rememberTerminal(bot *Bot) {
+	if c.cache != nil && bot.CurrentStatus().IsTerminal() {
+		c.cache.Set(c.cacheKey("terminal", bot.ID), terminalMarker)
+	}
+}
+
+// cacheKey returns the key of a cache entry. Keys are scoped by API host and token,
+// so that clients of different accounts sharing a store never see each other's responses.
+func (c *Client) cacheKey(kind, key string) string {
+	account := sha256.Sum256([]byte(c.Token))
+	return fmt.Sprintf("recallai:%s:%x:%s:%s", c.baseUrl.Host, account[:8], kind, key)
+}
+
+// cachedRequest performs a GET request of a resource of the bot, answering it from the cache
+// if the bot is known to be terminal. The body of the response is fully read before it is returned.
+func (c *Client) cachedRequest(ctx context.Context, botID, path string, q *query) (*http.Response, error) {
+	if c.cache == nil {
+		return c.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
+	}
+
+	if _, terminal := c.cache.Get(c.cacheKey("terminal", botID)); !terminal {
+		return c.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
+	}
+
+	key := c.cacheKey("response", path+"?"+q.encode())
+	if body, ok := c.cache.Get(key); ok {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    &http.Request{Method: http.MethodGet, URL: c.baseUrl.JoinPath("api", string(APIVersionV1), path)},
+		}, nil
+	}
+
+	res, err := c.request(ctx, http.MethodGet, path, q, nil, APIVersionV1)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	c.cache.Set(key, body)
+	c.indexBotKey(c.cache, botID, key)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+// indexBotKey records in the store that its entry under key holds data of the bot, so that
+// forgetBot can evict it.
+func (c *Client) indexBotKey(store CacheStore, botID, key string) {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+
+	indexKey := c.cacheKey("keys", botID)
+	index, _ := store.Get(indexKey)
+	keys := strings.Split(string(index), "\n")
+	if slices.Contains(keys, key) {
+		return
+	}
+	if len(index) == 0 {
+		keys = nil
+	}
+	store.Set(indexKey, []byte(strings.Join(append(keys, key), "\n")))
+}
+
+// forgetBot evicts the cached responses and the ETag entries of the bot, e.g. once its media was
+// deleted, so that the client stops serving its data.
+func (c *Client) forgetBot(botID string) {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+
+	indexKey := c.cacheKey("keys", botID)
+	for _, store := range []CacheStore{c.cache, c.etags} {
+		if store == nil {
+			continue
+		}
+		if index, ok := store.Get(indexKey); ok {
+			for _, key := range strings.Split(string(index), "\n") {
+				store.Delete(key)
+			}
+			store.Delete(indexKey)
+		}
+	}
+	if c.cache != nil {
+		c.cache.Delete(c.cacheKey("terminal", botID))
+	}
+}
+
+// MemoryCacheOptions configures a MemoryCache.
+type MemoryCacheOptions struct {
+	// How long entries are kept. Defaults to 24 hours.
+	TTL time.Duration
+	// The maximum number of entries. Defaults to 1000.
+	MaxEntries int
+	// The maximum total size of the values in bytes. Defaults to 64 MiB.
+	MaxBytes int
+	// The clock used for expiry. Defaults to SystemClock.
+	Clock Clock
+}
+
+// MemoryCache is an in-memory CacheStore evicting the least recently used entries
+// beyond its size bounds. It is safe for concurrent use.
+type MemoryCache struct {
+	opts MemoryCacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache creates a new MemoryCache.
+func NewMemoryCache(opts MemoryCacheOptions) *MemoryCache {
+	if opts.TTL <= 0 {
+		opts.TTL = 24 * time.Hour
+	}
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = 1000
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = 64 << 20
+	}
+	if opts.Clock == nil {
+		opts.Clock = SystemClock{}
+	}
+
+	return &MemoryCache{
+		opts:    opts,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*memoryCacheEntry)
+	if !m.opts.Clock.Now().Before(entry.expiresAt) {
+		m.remove(elem)
+		return nil, false
+	}
+
+	m.lru.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (m *MemoryCache) Set(key string, value []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+	if len(value) > m.opts.MaxBytes {
+		return
+	}
+
+	entry := &memoryCacheEntry{key: key, value: value, expiresAt: m.opts.Clock.Now().Add(m.opts.TTL)}
+	m.entries[key] = m.lru.PushFront(entry)
+	m.size += len(value)
+
+	for m.lru.Len() > m.opts.MaxEntries || m.size > m.opts.MaxBytes {
+		m.remove(m.lru.Back())
+	}
+}
+
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Len returns the number of entries, including expired ones not evicted yet.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lru.Len()
+}
+
+func (m *MemoryCache) remove(elem *list.Element) {
+	entry := m.lru.Remove(elem).(*memoryCacheEntry)
+	delete(m.entries, entry.key)
+	m.size -= len(entry.value)
+}
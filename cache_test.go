@@ -0,0 +1,143 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestCache(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         string
+		params         recallaigo.GetBotTranscriptParams
+		wantTranscript int
+		wantTimeline   int
+	}{
+		{
+			name:           "caches responses of terminal bots",
+			status:         "done",
+			wantTranscript: 2,
+			wantTimeline:   2,
+		},
+		{
+			name:           "fetches responses of active bots",
+			status:         "in_call_recording",
+			wantTranscript: 4,
+			wantTimeline:   4,
+		},
+		{
+			name:           "fetches async transcripts",
+			status:         "done",
+			params:         recallaigo.GetBotTranscriptParams{UseAsyncTranscription: true},
+			wantTranscript: 4,
+			wantTimeline:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := make(map[string]int)
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				switch {
+				case strings.HasSuffix(req.URL.Path, "/transcript"):
+					requests["transcript"]++
+					return testutil.NewFileResponse(t, "test_data/get_bot_transcript.json", http.StatusOK)
+				case strings.HasSuffix(req.URL.Path, "/speaker_timeline"):
+					requests["timeline"]++
+					return testutil.NewFileResponse(t, "test_data/get_speaker_timeline.json", http.StatusOK)
+				}
+				return testutil.NewStringResponse(`{"id": "some_id", "status_changes": [{"code": "`+tt.status+`"}]}`, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token",
+				recallaigo.WithHTTPClient(c),
+				recallaigo.WithCache(recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{})),
+			)
+			ctx := context.Background()
+
+			// The status of the bot is unknown before the first retrieval.
+			fetch := func() {
+				transcript, err := client.Bot.GetBotTranscript(ctx, "some_id", tt.params)
+				if err != nil {
+					t.Fatalf("GetBotTranscript() error = %v", err)
+				}
+				if len(transcript) == 0 {
+					t.Error("GetBotTranscript() returned no entries")
+				}
+				if _, err := client.Bot.GetSpeakerTimeline(ctx, "some_id"); err != nil {
+					t.Fatalf("GetSpeakerTimeline() error = %v", err)
+				}
+			}
+			fetch()
+			if _, err := client.Bot.RetrieveBot(ctx, "some_id"); err != nil {
+				t.Fatalf("RetrieveBot() error = %v", err)
+			}
+			for i := 0; i < 3; i++ {
+				fetch()
+			}
+
+			if requests["transcript"] != tt.wantTranscript {
+				t.Errorf("fetched the transcript %d times, want %d", requests["transcript"], tt.wantTranscript)
+			}
+			if requests["timeline"] != tt.wantTimeline {
+				t.Errorf("fetched the speaker timeline %d times, want %d", requests["timeline"], tt.wantTimeline)
+			}
+		})
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	t.Run("expires entries after the TTL", func(t *testing.T) {
+		clock := recalltest.NewFakeClock(time.Now())
+		cache := recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{TTL: time.Minute, Clock: clock})
+
+		cache.Set("key", []byte("value"))
+		clock.Advance(59 * time.Second)
+		if _, ok := cache.Get("key"); !ok {
+			t.Error("Get() missed an entry before its TTL")
+		}
+		clock.Advance(time.Second)
+		if _, ok := cache.Get("key"); ok {
+			t.Error("Get() found an entry after its TTL")
+		}
+	})
+
+	t.Run("evicts the least recently used entries", func(t *testing.T) {
+		cache := recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{MaxEntries: 2})
+
+		cache.Set("a", []byte("1"))
+		cache.Set("b", []byte("2"))
+		cache.Get("a")
+		cache.Set("c", []byte("3"))
+
+		if _, ok := cache.Get("b"); ok {
+			t.Error("Get() found the least recently used entry")
+		}
+		for _, key := range []string{"a", "c"} {
+			if _, ok := cache.Get(key); !ok {
+				t.Errorf("Get(%q) missed a recently used entry", key)
+			}
+		}
+	})
+
+	t.Run("bounds the total size", func(t *testing.T) {
+		cache := recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{MaxBytes: 10})
+
+		cache.Set("a", []byte("123456"))
+		cache.Set("b", []byte("123456"))
+		cache.Set("huge", []byte("12345678901"))
+
+		if cache.Len() != 1 {
+			t.Errorf("Len() = %d, want 1", cache.Len())
+		}
+		if _, ok := cache.Get("b"); !ok {
+			t.Error("Get() missed the entry within the size bound")
+		}
+	})
+}
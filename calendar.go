@@ -0,0 +1,81 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MetadataKeyCalendarEventID is the metadata key linking bots scheduled by ScheduleBotForCalendarEvent
+// to their calendar event.
+const MetadataKeyCalendarEventID = "calendar_event_id"
+
+// CalendarEventInput is a calendar event with a meeting that the caller read from a calendar,
+// e.g. from the Google Calendar or Microsoft Graph API of the calendar of a user. It is not an
+// event of the calendar integration of Recall.ai.
+type CalendarEventInput struct {
+	// The ID of the event in its calendar.
+	ID         string
+	Title      string
+	MeetingURL string
+	Start      time.Time
+	End        time.Time
+}
+
+// ScheduleOptions configures the scheduling of bots for calendar events and recurring meetings.
+type ScheduleOptions struct {
+	// How long before the start of the event the bot joins, e.g. 2 minutes to be admitted
+	// before the meeting starts.
+	JoinEarly time.Duration
+	// The clock past occurrences and events are told apart by. Defaults to SystemClock.
+	Clock Clock
+}
+
+// ScheduleBotForCalendarEvent schedules a bot configured like the template to join the meeting of
+// the event at its start. The metadata of the bot links it to the event with MetadataKeyCalendarEventID.
+// Events whose join time has passed are rejected. The template is not modified.
+func ScheduleBotForCalendarEvent(ctx context.Context, bots BotService, event CalendarEventInput, template *CreateBotRequest, opts ...ScheduleOptions) (*Bot, error) {
+	request, err := calendarEventRequest(event, template, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return bots.CreateBot(ctx, request)
+}
+
+// calendarEventRequest returns the request of a bot for the event, configured like the template.
+func calendarEventRequest(event CalendarEventInput, template *CreateBotRequest, opts ...ScheduleOptions) (*CreateBotRequest, error) {
+	var opt ScheduleOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Clock == nil {
+		opt.Clock = SystemClock{}
+	}
+	if event.ID == "" {
+		return nil, errors.New("calendar event ID is required")
+	}
+	if event.MeetingURL == "" {
+		return nil, errors.New("calendar event has no meeting URL")
+	}
+	if event.Start.IsZero() {
+		return nil, errors.New("calendar event has no start time")
+	}
+	joinAt := event.Start.Add(-opt.JoinEarly)
+	if !joinAt.After(opt.Clock.Now()) {
+		return nil, fmt.Errorf("calendar event %s is in the past: the bot would join at %s", event.ID, joinAt.Format(time.RFC3339))
+	}
+
+	request := &CreateBotRequest{}
+	if template != nil {
+		*request = *template
+	}
+	request.MeetingURL = event.MeetingURL
+	request.JoinAt = ScheduleAt(joinAt)
+	request.Metadata = request.Metadata.Clone()
+	if request.Metadata == nil {
+		request.Metadata = Metadata{}
+	}
+	request.Metadata[MetadataKeyCalendarEventID] = event.ID
+	return request, nil
+}
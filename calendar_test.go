@@ -0,0 +1,66 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestScheduleBotForCalendarEvent(t *testing.T) {
+	var body struct {
+		MeetingURL string            `json:"meeting_url"`
+		BotName    string            `json:"bot_name"`
+		JoinAt     string            `json:"join_at"`
+		Metadata   map[string]string `json:"metadata"`
+	}
+	router := testutil.NewRouter()
+	router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+	template := &recallaigo.CreateBotRequest{BotName: "Notetaker", Metadata: recallaigo.Metadata{"customer": "42"}}
+	event := recallaigo.CalendarEventInput{
+		ID:         "event_1",
+		MeetingURL: "https://zoom.us/j/123",
+		Start:      time.Date(2025, 3, 18, 11, 0, 0, 0, time.FixedZone("CET", 60*60)),
+	}
+
+	opts := recallaigo.ScheduleOptions{JoinEarly: 2 * time.Minute, Clock: recalltest.NewFakeClock(time.Date(2025, 3, 18, 9, 0, 0, 0, time.UTC))}
+	_, err := recallaigo.ScheduleBotForCalendarEvent(context.Background(), client.Bot, event, template, opts)
+	if err != nil {
+		t.Fatalf("ScheduleBotForCalendarEvent() error = %v", err)
+	}
+
+	if body.MeetingURL != event.MeetingURL || body.BotName != "Notetaker" {
+		t.Errorf("sent meeting URL and bot name = %q, %q", body.MeetingURL, body.BotName)
+	}
+	if want := "2025-03-18T09:58:00Z"; body.JoinAt != want {
+		t.Errorf("sent join_at = %q, want %q", body.JoinAt, want)
+	}
+	if body.Metadata["calendar_event_id"] != "event_1" || body.Metadata["customer"] != "42" {
+		t.Errorf("sent metadata = %v", body.Metadata)
+	}
+	if _, ok := template.Metadata[recallaigo.MetadataKeyCalendarEventID]; ok || template.MeetingURL != "" {
+		t.Errorf("ScheduleBotForCalendarEvent() modified the template: %+v", template)
+	}
+
+	late := recallaigo.ScheduleOptions{Clock: recalltest.NewFakeClock(time.Date(2025, 3, 18, 10, 30, 0, 0, time.UTC))}
+	if _, err := recallaigo.ScheduleBotForCalendarEvent(context.Background(), client.Bot, event, template, late); err == nil {
+		t.Error("ScheduleBotForCalendarEvent() of a past event succeeded")
+	}
+
+	event.MeetingURL = ""
+	if _, err := recallaigo.ScheduleBotForCalendarEvent(context.Background(), client.Bot, event, template, opts); err == nil {
+		t.Error("ScheduleBotForCalendarEvent() without meeting URL succeeded")
+	}
+}
@@ -0,0 +1,73 @@
+package recallaigo
+
+// PlatformCapabilities lists the features of bots that depend on the meeting platform.
+type PlatformCapabilities struct {
+	// Sending chat messages with SendChatMessage.
+	Chat bool
+	// Outputting audio with OutputAudio.
+	OutputAudio bool
+	// Outputting video with OutputVideo.
+	OutputVideo bool
+	// Asking the host for permission to record with RequestRecordingPermission.
+	RequestRecordingPermission bool
+	// Choosing the breakout room to join with CreateBotRequest.BreakoutRoom.
+	BreakoutRooms bool
+}
+
+// platformCapabilities is the documented support of the features by platform.
+// Platforms missing from it support none of them.
+var platformCapabilities = map[Platform]PlatformCapabilities{
+	PlatformZoom: {
+		Chat:                       true,
+		OutputAudio:                true,
+		OutputVideo:                true,
+		RequestRecordingPermission: true,
+		BreakoutRooms:              true,
+	},
+	PlatformGoogleMeet: {
+		Chat:        true,
+		OutputAudio: true,
+		OutputVideo: true,
+	},
+	PlatformMicrosoftTeams: {
+		Chat:        true,
+		OutputAudio: true,
+		OutputVideo: true,
+	},
+	PlatformMicrosoftTeamsLive: {
+		Chat:        true,
+		OutputAudio: true,
+		OutputVideo: true,
+	},
+}
+
+// Capabilities returns the features bots support on the platform, e.g. to disable the actions
+// of a UI that would be rejected by the API.
+func (p Platform) Capabilities() PlatformCapabilities {
+	return platformCapabilities[p]
+}
+
+// SupportsChat reports whether bots can send chat messages on the platform.
+func (p Platform) SupportsChat() bool {
+	return platformCapabilities[p].Chat
+}
+
+// SupportsOutputAudio reports whether bots can output audio on the platform.
+func (p Platform) SupportsOutputAudio() bool {
+	return platformCapabilities[p].OutputAudio
+}
+
+// SupportsOutputVideo reports whether bots can output video on the platform.
+func (p Platform) SupportsOutputVideo() bool {
+	return platformCapabilities[p].OutputVideo
+}
+
+// SupportsBreakoutRooms reports whether bots can be configured to join breakout rooms on the platform.
+func (p Platform) SupportsBreakoutRooms() bool {
+	return platformCapabilities[p].BreakoutRooms
+}
+
+// SupportsRequestRecordingPermission reports whether bots can ask the host for permission to record on the platform.
+func (p Platform) SupportsRequestRecordingPermission() bool {
+	return platformCapabilities[p].RequestRecordingPermission
+}
@@ -0,0 +1,50 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestPlatformCapabilities(t *testing.T) {
+	tests := []struct {
+		platform recallaigo.Platform
+		want     recallaigo.PlatformCapabilities
+	}{
+		{recallaigo.PlatformZoom, recallaigo.PlatformCapabilities{Chat: true, OutputAudio: true, OutputVideo: true, RequestRecordingPermission: true, BreakoutRooms: true}},
+		{recallaigo.PlatformGoogleMeet, recallaigo.PlatformCapabilities{Chat: true, OutputAudio: true, OutputVideo: true}},
+		{recallaigo.PlatformMicrosoftTeams, recallaigo.PlatformCapabilities{Chat: true, OutputAudio: true, OutputVideo: true}},
+		{recallaigo.PlatformMicrosoftTeamsLive, recallaigo.PlatformCapabilities{Chat: true, OutputAudio: true, OutputVideo: true}},
+		{recallaigo.PlatformSlackHuddleObserver, recallaigo.PlatformCapabilities{}},
+		{recallaigo.Platform("unknown"), recallaigo.PlatformCapabilities{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.platform.String(), func(t *testing.T) {
+			if got := tt.platform.Capabilities(); got != tt.want {
+				t.Errorf("Capabilities() = %+v, want %+v", got, tt.want)
+			}
+
+			got := recallaigo.PlatformCapabilities{
+				Chat:                       tt.platform.SupportsChat(),
+				OutputAudio:                tt.platform.SupportsOutputAudio(),
+				OutputVideo:                tt.platform.SupportsOutputVideo(),
+				RequestRecordingPermission: tt.platform.SupportsRequestRecordingPermission(),
+				BreakoutRooms:              tt.platform.SupportsBreakoutRooms(),
+			}
+			if got != tt.want {
+				t.Errorf("Supports*() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeetingURLCapabilities(t *testing.T) {
+	platform := recallaigo.Platform(recallaigo.ParseMeetingURL("https://teams.live.com/meet/9876543210?p=abcdef").Platform)
+	if platform != recallaigo.PlatformMicrosoftTeamsLive {
+		t.Fatalf("ParseMeetingURL() platform = %s, want %s", platform, recallaigo.PlatformMicrosoftTeamsLive)
+	}
+	if !platform.SupportsChat() || !platform.SupportsOutputAudio() || !platform.SupportsOutputVideo() {
+		t.Errorf("Capabilities() = %+v, want chat, output audio and output video", platform.Capabilities())
+	}
+}
@@ -6,22 +6,52 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
+	"sync"
 )
 
-type apiVersion string
+// APIVersion is a version of the Recall.ai API, the first path segment after "/api/".
+type APIVersion string
 
 const (
-	apiVersionV1     = "v1"
-	apiVersionV2Beta = "v2beta"
+	APIVersionV1     APIVersion = "v1"
+	APIVersionV2Beta APIVersion = "v2beta"
 )
 
+// Token is an API key of Recall.ai. It is masked wherever it is formatted or logged, e.g. when
+// a Client is printed, showing only its last 4 characters. Convert it to a string for the key.
 type Token string
 
+// String returns the masked token, e.g. "tok_****abcd". Tokens shorter than 8 characters are fully masked.
 func (t Token) String() string {
-	return string(t)
+	if len(t) < 8 {
+		return "tok_****"
+	}
+	return "tok_****" + string(t[len(t)-4:])
+}
+
+// Format masks the token for every verb, including %#v.
+func (t Token) Format(f fmt.State, verb rune) {
+	if verb == 'q' {
+		fmt.Fprintf(f, "%q", t.String())
+		return
+	}
+	io.WriteString(f, t.String())
+}
+
+// LogValue masks the token in log/slog records.
+func (t Token) LogValue() slog.Value {
+	return slog.StringValue(t.String())
+}
+
+// MarshalText masks the token in encodings such as JSON, e.g. of a Client logged by a JSON handler.
+// The key does not survive such an encoding; store it as a string instead.
+func (t Token) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
 }
 
 type Region string
@@ -49,8 +79,51 @@ type Client struct {
 	baseUrl    *url.URL
 	Region     Region
 	Token      Token
+	// Reject responses with fields unknown to this package instead of ignoring them.
+	strictDecoding bool
+	// Called for response fields skipped by tolerant decoding, nil unless tolerant decoding is enabled.
+	onDecodeWarning func(DecodeWarning)
+	// Keep the entries of transcripts as returned by the API in TranscriptEntry.Raw.
+	rawTranscripts bool
+	clock          Clock
+	retry          RetryPolicy
+	// GET requests in flight, nil unless singleflight is enabled.
+	flights *flightGroup
+	// Responses of bots in a terminal status, nil unless caching is enabled.
+	cache CacheStore
+	// Responses with their ETag, nil unless conditional requests are enabled.
+	etags CacheStore
+	// Guards the indexes of the cached entries of each bot, see indexBotKey.
+	indexMu *sync.Mutex
+	// The regions of known bots, nil unless the region guard is enabled.
+	regions CacheStore
+	// Supplies the Zoom parameters of created bots, nil unless set with WithZoomTokens.
+	zoomTokens ZoomTokenProvider
+	// The variants of created bots for the platforms their request sets none for.
+	defaultVariants Variant
+
+	Bot   BotService
+	Media MediaService
+}
+
+// Recall is the API of a Client as an interface, so that applications can depend on it and
+// replace the whole client in tests, e.g. with a testutil.FakeRecall. Services added to the
+// client are added to it as well.
+type Recall interface {
+	BotService() BotService
+	MediaService() MediaService
+}
+
+var _ Recall = (*Client)(nil)
 
-	Bot BotService
+// BotService returns the Bot service of the client.
+func (c *Client) BotService() BotService {
+	return c.Bot
+}
+
+// MediaService returns the Media service of the client.
+func (c *Client) MediaService() MediaService {
+	return c.Media
 }
 
 func NewClient(token string, opts ...ClientOption) *Client {
@@ -58,9 +131,12 @@ func NewClient(token string, opts ...ClientOption) *Client {
 		httpClient: http.DefaultClient,
 		Token:      Token(token),
 		Region:     UsEast,
+		clock:      SystemClock{},
+		indexMu:    new(sync.Mutex),
 	}
 
 	client.Bot = &BotClient{client: client}
+	client.Media = &MediaClient{client: client}
 
 	if err := client.setBaseURL(client.Region); err != nil {
 		panic(fmt.Errorf("failed to set base URL: %w", err))
@@ -100,59 +176,244 @@ func WithRegion(region Region) ClientOption {
 	}
 }
 
-func (c *Client) request(ctx context.Context, method, urlStr string, queryParams map[string][]string, requestBody interface{}, apiVersion apiVersion) (*http.Response, error) {
+// WithStrictDecoding makes the client fail on response fields that are unknown to this package.
+// It is meant for contract checks against the API, e.g. in CI. By default unknown fields are ignored,
+// so that additions to the API do not break existing code.
+// Fields inside types with their own JSON decoding, such as WordDetail and MeetingURL, are checked
+// too, except for the arbitrary keys of Metadata and IntelligenceResult.
+func WithStrictDecoding(strict bool) ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = strict
+	}
+}
+
+// WithRawTranscripts makes the client keep every transcript entry as returned by the API in
+// TranscriptEntry.Raw, e.g. to read provider-specific fields such as sentiment. It is off by
+// default, as it holds a copy of every entry in memory.
+func WithRawTranscripts(keep bool) ClientOption {
+	return func(c *Client) {
+		c.rawTranscripts = keep
+	}
+}
+
+// WithBaseURL overrides the base URL derived from the region, e.g. to send requests through
+// a proxy or to a fake server in tests. Apply it after WithRegion, which resets the base URL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			panic(fmt.Errorf("failed to parse base URL: %w", err))
+		}
+		// Request paths are resolved relative to the base URL, so it must end with a slash.
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.baseUrl = u
+	}
+}
+
+func (c *Client) request(ctx context.Context, method, urlStr string, q *query, requestBody interface{}, apiVersion APIVersion) (*http.Response, error) {
 	// Construct the request URL
 	u, err := c.baseUrl.Parse(fmt.Sprintf("api/%s/%s", apiVersion, urlStr))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse request URL: %w", err)
 	}
 
-	// Prepare the request body
-	var buf io.ReadWriter
-	if requestBody != nil && !reflect.ValueOf(requestBody).IsNil() {
-		body, err := json.Marshal(requestBody)
+	// Prepare the request body, streamed or in a pooled buffer, as media payloads can be several megabytes
+	var (
+		body  *pooledBody
+		write func(w io.Writer) error
+	)
+	if streamer, ok := requestBody.(jsonStreamer); ok && !reflect.ValueOf(requestBody).IsNil() {
+		write = streamer.streamJSON()
+	}
+	if write == nil && requestBody != nil && !reflect.ValueOf(requestBody).IsNil() {
+		body, err = newPooledBody(requestBody)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		buf = bytes.NewBuffer(body)
+		defer body.release()
 	}
 
 	// Add query parameters to the URL
-	if len(queryParams) > 0 {
-		q := u.Query()
-		for k, values := range queryParams {
-			for _, value := range values {
-				q.Add(k, value)
-			}
-		}
-		u.RawQuery = q.Encode()
+	if rawQuery := q.encode(); rawQuery != "" {
+		u.RawQuery = rawQuery
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new HTTP request: %w", err)
 	}
+	switch {
+	case write != nil:
+		req.Body = newStreamBody(write)
+	case body != nil:
+		body.attach(req)
+	}
 
 	// Set headers
+	c.setHeaders(req)
+
+	return c.send(req)
+}
+
+// setHeaders sets the headers of a JSON request to the API, including its authorization.
+func (c *Client) setHeaders(req *http.Request) {
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Token %s", c.Token))
+	req.Header.Set("Authorization", "Token "+string(c.Token))
+}
 
-	// Execute the request
-	res, err := c.httpClient.Do(req)
+// send executes a request to the API, returning an error for unsuccessful responses.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if err := c.checkRegion(strings.TrimPrefix(req.URL.Path, c.baseUrl.Path)); err != nil {
+		// Unblock the writer of streamed bodies, which would otherwise wait for the transport forever.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	res, err := c.doConditional(req)
 	if err != nil {
 		return nil, fmt.Errorf("HTTP request failed: %w", err)
 	}
 
 	// Handle non-OK responses
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		data, err := io.ReadAll(res.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read error response body: %w", err)
+	if err := checkResponse(req.Context(), res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// requestRaw performs a request whose response is not JSON, such as binary media content.
+// urlStr is either a path relative to the API base URL or an absolute URL, e.g. a pre-signed
+// download URL. The authorization header is only sent to the API host, and redirects to other
+// hosts are followed without it.
+func (c *Client) requestRaw(ctx context.Context, method, urlStr string, header http.Header) (*http.Response, error) {
+	u, err := c.baseUrl.Parse(urlStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new HTTP request: %w", err)
+	}
+
+	for k, values := range header {
+		for _, value := range values {
+			req.Header.Add(k, value)
 		}
+	}
+	if u.Host == c.baseUrl.Host {
+		req.Header.Set("Authorization", "Token "+string(c.Token))
+	}
 
-		return nil, fmt.Errorf("API request failed: %s", string(data))
+	res, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+
+	if err := checkResponse(req.Context(), res); err != nil {
+		return nil, err
 	}
 
 	return res, nil
 }
+
+// checkResponse returns an *Error for unsuccessful responses, or the typed error wrapping it for
+// well-known failures, closing their body. See isSuccess for the successful ones.
+func checkResponse(ctx context.Context, res *http.Response) error {
+	if isSuccess(ctx, res.StatusCode) {
+		return nil
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read error response body: %w", err)
+	}
+
+	return typedError(newError(res.StatusCode, data))
+}
+
+// newDecoder returns a JSON decoder for a response body, honoring the decoding mode of the client.
+func (c *Client) newDecoder(r io.Reader) *json.Decoder {
+	dec := json.NewDecoder(r)
+	if c.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	return dec
+}
+
+// decode decodes the JSON body of a response into v.
+func (c *Client) decode(res *http.Response, v any) error {
+	if !c.strictDecoding {
+		return c.tolerate(res, c.newDecoder(res.Body).Decode(v))
+	}
+
+	// The fields of types with their own JSON decoding are checked on the whole body afterwards
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	return c.decodeBytes(res, data, v)
+}
+
+// decodeBytes decodes a JSON value of a response body into v, honoring the decoding mode of the client.
+func (c *Client) decodeBytes(res *http.Response, data []byte, v any) error {
+	if err := c.tolerate(res, c.newDecoder(bytes.NewReader(data)).Decode(v)); err != nil {
+		return err
+	}
+	if c.strictDecoding {
+		return checkFields(data, reflect.TypeOf(v), "")
+	}
+	return nil
+}
+
+// decodeTranscript decodes the JSON body of a transcript response into entries, keeping each
+// entry in Raw if enabled with WithRawTranscripts.
+func (c *Client) decodeTranscript(res *http.Response, entries *[]TranscriptEntry) error {
+	if !c.rawTranscripts {
+		return c.decode(res, entries)
+	}
+
+	var raws []json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&raws); err != nil {
+		return err
+	}
+	*entries = make([]TranscriptEntry, len(raws))
+	for i, raw := range raws {
+		if err := c.decodeRawTranscriptEntry(res, raw, &(*entries)[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeTranscriptEntry decodes the next entry of a transcript from dec, keeping it in Raw if
+// enabled with WithRawTranscripts.
+func (c *Client) decodeTranscriptEntry(res *http.Response, dec *json.Decoder, entry *TranscriptEntry) error {
+	if !c.rawTranscripts && !c.strictDecoding {
+		return c.tolerate(res, dec.Decode(entry))
+	}
+
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+	return c.decodeRawTranscriptEntry(res, raw, entry)
+}
+
+// decodeRawTranscriptEntry decodes a transcript entry, keeping it in Raw if enabled with WithRawTranscripts.
+func (c *Client) decodeRawTranscriptEntry(res *http.Response, raw json.RawMessage, entry *TranscriptEntry) error {
+	if err := c.decodeBytes(res, raw, entry); err != nil {
+		return err
+	}
+	if c.rawTranscripts {
+		entry.Raw = raw
+	}
+	return nil
+}
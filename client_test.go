@@ -1,52 +1,26 @@
 package recallaigo_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
-	"os"
+	"strings"
 	"testing"
 
 	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
 )
 
-// RoundTripFunc .
-type RoundTripFunc func(req *http.Request) *http.Response
-
-// RoundTrip .
-func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
-	return f(req), nil
-}
-
-// newTestClient returns *http.Client with Transport replaced to avoid making real calls
-func newTestClient(fn RoundTripFunc) *http.Client {
-	return &http.Client{
-		Transport: fn,
-	}
-}
-
-// newMockedClient returns *http.Client which responds with content from given file
-func newMockedClient(t *testing.T, requestMockFile string, statusCode int) *http.Client {
-	return newTestClient(func(*http.Request) *http.Response {
-		b, err := os.Open(requestMockFile)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		resp := &http.Response{
-			StatusCode: statusCode,
-			Body:       b,
-			Header:     make(http.Header),
-		}
-		return resp
-	})
-}
-
 func TestNewClient(t *testing.T) {
 	token := "test-token"
 
 	client := recallaigo.NewClient(token)
 
-	if client.Token.String() != token {
-		t.Errorf("expected token %s, got %s", token, client.Token)
+	if string(client.Token) != token {
+		t.Errorf("expected token %s, got %s", token, string(client.Token))
 	}
 
 	if client.Region != recallaigo.UsEast {
@@ -60,11 +34,166 @@ func TestNewClientWithCustomRegion(t *testing.T) {
 
 	client := recallaigo.NewClient(token, recallaigo.WithRegion(customRegion))
 
-	if client.Token.String() != token {
-		t.Errorf("expected token %s, got %s", token, client.Token)
+	if string(client.Token) != token {
+		t.Errorf("expected token %s, got %s", token, string(client.Token))
 	}
 
 	if client.Region != customRegion {
 		t.Errorf("expected region %s, got %s", customRegion, client.Region)
 	}
 }
+
+func TestTokenMasking(t *testing.T) {
+	const token = "0123456789abcdef"
+	client := recallaigo.NewClient(token)
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("client", "token", client.Token, "client", client)
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("client", "token", client.Token, "client", client)
+
+	outputs := []string{
+		client.Token.String(),
+		fmt.Sprint(client.Token),
+		fmt.Sprintf("%s %v %q %#v %x", client.Token, client.Token, client.Token, client.Token, client.Token),
+		fmt.Sprintf("%v %+v %#v", *client, *client, *client),
+		buf.String(),
+	}
+	for _, output := range outputs {
+		if strings.Contains(output, token) || strings.Contains(output, "0123456789") {
+			t.Errorf("output contains the token: %s", output)
+		}
+		if !strings.Contains(output, "tok_****cdef") {
+			t.Errorf("output does not contain the masked token: %s", output)
+		}
+	}
+
+	if got := recallaigo.Token("short").String(); got != "tok_****" {
+		t.Errorf("String() = %q, want %q", got, "tok_****")
+	}
+}
+
+func TestRequestRawAuthorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		wantAuth bool
+	}{
+		{
+			name:     "sends token to the API host",
+			url:      "https://us-east-1.recall.ai/api/v1/bot/123/video",
+			wantAuth: true,
+		},
+		{
+			name:     "omits token for pre-signed URLs",
+			url:      "https://media.test/video.mp4?sig=secret",
+			wantAuth: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAuth string
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				gotAuth = req.Header.Get("Authorization")
+				return testutil.NewStringResponse("", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			if _, err := client.Media.Download(context.Background(), tt.url, io.Discard); err != nil {
+				t.Fatalf("Download() error = %v", err)
+			}
+			if (gotAuth != "") != tt.wantAuth {
+				t.Errorf("Authorization = %q, wantAuth %v", gotAuth, tt.wantAuth)
+			}
+		})
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		strict  bool
+		wantErr bool
+	}{
+		{
+			name:   "ignores unknown fields by default",
+			body:   `{"id": "123", "new_field": true}`,
+			strict: false,
+		},
+		{
+			name:    "rejects unknown fields when strict",
+			body:    `{"id": "123", "new_field": true}`,
+			strict:  true,
+			wantErr: true,
+		},
+		{
+			name:    "rejects unknown fields of meeting URLs when strict",
+			body:    `{"id": "123", "meeting_url": {"meeting_id": "abc", "platform": "zoom", "new_field": true}}`,
+			strict:  true,
+			wantErr: true,
+		},
+		{
+			name:   "accepts known fields of meeting URLs when strict",
+			body:   `{"id": "123", "meeting_url": {"meeting_id": "abc", "platform": "zoom"}, "metadata": {"any_key": 1}}`,
+			strict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(*http.Request) *http.Response {
+				return testutil.NewStringResponse(tt.body, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithStrictDecoding(tt.strict))
+
+			bot, err := client.Bot.RetrieveBot(context.Background(), "123")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RetrieveBot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && bot.ID != "123" {
+				t.Errorf("RetrieveBot() id = %q, want %q", bot.ID, "123")
+			}
+		})
+	}
+}
+
+func TestStrictDecodingTranscript(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name: "accepts known fields",
+			body: `[{"speaker": "Alice", "words": [{"text": "Hi", "start_timestamp": {"relative": 1.5}, "end_timestamp": 2}]}]`,
+		},
+		{
+			name:    "rejects unknown fields of words",
+			body:    `[{"speaker": "Alice", "words": [{"text": "Hi", "new_field": true}]}]`,
+			wantErr: true,
+		},
+		{
+			name:    "rejects unknown fields of word timestamps",
+			body:    `[{"speaker": "Alice", "words": [{"text": "Hi", "start_timestamp": {"relative": 1.5, "absolute": "2025-03-18T10:13:10Z"}}]}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(*http.Request) *http.Response {
+				return testutil.NewStringResponse(tt.body, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithStrictDecoding(true))
+
+			if _, err := client.Bot.GetBotTranscript(context.Background(), "bot_id"); (err != nil) != tt.wantErr {
+				t.Errorf("GetBotTranscript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			err := client.Bot.StreamBotTranscript(context.Background(), "bot_id", func(recallaigo.TranscriptEntry) error { return nil })
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StreamBotTranscript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
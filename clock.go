@@ -0,0 +1,44 @@
+package recallaigo
+
+import "time"
+
+// Clock provides the current time and tickers to the time-dependent features of this package,
+// such as WaitForStatus, PollTranscript, TranscribeAndWait, StuckBotMonitor and BotPool.
+// Tests can replace it with a fake clock to run these features without waiting,
+// e.g. the one of the recalltest package.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks at intervals, like time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// SystemClock is the Clock of the real time. It is the default of every feature using a Clock.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+type systemTicker struct {
+	*time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// WithClock sets the clock used by the client for polling. Defaults to SystemClock.
+func WithClock(clock Clock) ClientOption {
+	return func(c *Client) {
+		c.clock = clock
+	}
+}
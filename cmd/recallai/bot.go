@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func (c *cli) botCreate(ctx context.Context, args []string) error {
+	fs := c.flags("bot create")
+	meetingURL := fs.String("meeting-url", "", "the URL of the meeting to join")
+	name := fs.String("name", "Meeting Notetaker", "the name of the bot in the call")
+	joinIn := fs.Duration("join-in", 0, "schedule the bot to join after this duration instead of now, e.g. 1h")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *meetingURL == "" || fs.NArg() != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	request := &recallaigo.CreateBotRequest{MeetingURL: *meetingURL, BotName: *name}
+	if *joinIn > 0 {
+		request.JoinAt = recallaigo.ScheduleIn(*joinIn)
+	}
+	bot, err := client.Bot.CreateBot(ctx, request)
+	if err != nil {
+		return err
+	}
+	return c.printJSON(bot)
+}
+
+func (c *cli) botList(ctx context.Context, args []string) error {
+	fs := c.flags("bot list")
+	status := fs.String("status", "", "only list bots with this status, e.g. done")
+	platform := fs.String("platform", "", "only list bots of this platform, e.g. zoom")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	params := &recallaigo.ListBotsParams{}
+	if *status != "" {
+		params.Status = []recallaigo.Status{recallaigo.Status(*status)}
+	}
+	if *platform != "" {
+		params.Platform = []recallaigo.Platform{recallaigo.Platform(*platform)}
+	}
+	bots, err := client.Bot.ListBots(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSTATUS\tMEETING")
+	for _, bot := range bots.Results {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", bot.ID, bot.BotName, bot.CurrentStatus(), bot.MeetingURL)
+	}
+	return w.Flush()
+}
+
+func (c *cli) botGet(ctx context.Context, args []string) error {
+	botID, err := c.parseWithBotID(c.flags("bot get"), args)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	bot, err := client.Bot.RetrieveBot(ctx, botID)
+	if err != nil {
+		return err
+	}
+	return c.printJSON(bot)
+}
+
+func (c *cli) botLeave(ctx context.Context, args []string) error {
+	botID, err := c.parseWithBotID(c.flags("bot leave"), args)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	bot, err := client.Bot.RemoveBotFromCall(ctx, botID)
+	if err != nil {
+		return err
+	}
+	return c.printJSON(bot)
+}
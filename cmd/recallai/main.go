@@ -0,0 +1,197 @@
+// Command recallai is a command line client of the Recall.ai API built on recallaigo.
+//
+// Usage:
+//
+//	recallai bot create -meeting-url URL [-name NAME] [-join-in DURATION]
+//	recallai bot list [-status STATUS] [-platform PLATFORM]
+//	recallai bot get BOT_ID
+//	recallai bot leave BOT_ID
+//	recallai transcript get [-format text|srt|json|jsonl] BOT_ID
+//	recallai media download [-kind video|audio] [-o FILE] BOT_ID
+//	recallai webhook listen [-addr ADDR]
+//
+// The credentials are read from the environment, falling back to the config file
+// $XDG_CONFIG_HOME/recallai/config.json, or the file named by RECALLAI_CONFIG:
+//
+//	RECALLAI_API_KEY          api_key         the API key of the account
+//	RECALLAI_REGION           region          the region of the account, defaults to us-east-1
+//	RECALLAI_BASE_URL         base_url        overrides the API URL of the region
+//	RECALLAI_WEBHOOK_SECRET   webhook_secret  the signing secret of the webhook endpoint, followed by
+//	                                          the previous ones separated by commas during a rotation
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+const usage = `usage: recallai <command> <subcommand> [flags] [args]
+
+commands:
+  bot create -meeting-url URL [-name NAME] [-join-in DURATION]
+  bot list [-status STATUS] [-platform PLATFORM]
+  bot get BOT_ID
+  bot leave BOT_ID
+  transcript get [-format text|srt|json|jsonl] BOT_ID
+  media download [-kind video|audio] [-o FILE] BOT_ID
+  webhook listen [-addr ADDR]
+`
+
+// errUsage is returned for invalid command lines, after the usage has been printed.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := &cli{stdout: os.Stdout, stderr: os.Stderr, getenv: os.Getenv}
+	if err := c.run(ctx, os.Args[1:]); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "recallai:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// cli runs the commands, with its environment injected for tests.
+type cli struct {
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(key string) string
+}
+
+// command runs a subcommand with its arguments.
+type command func(ctx context.Context, args []string) error
+
+func (c *cli) run(ctx context.Context, args []string) error {
+	commands := map[string]map[string]command{
+		"bot": {
+			"create": c.botCreate,
+			"list":   c.botList,
+			"get":    c.botGet,
+			"leave":  c.botLeave,
+		},
+		"transcript": {
+			"get": c.transcriptGet,
+		},
+		"media": {
+			"download": c.mediaDownload,
+		},
+		"webhook": {
+			"listen": c.webhookListen,
+		},
+	}
+
+	if len(args) < 2 {
+		return c.usageError()
+	}
+	cmd, ok := commands[args[0]][args[1]]
+	if !ok {
+		return c.usageError()
+	}
+	return cmd(ctx, args[2:])
+}
+
+func (c *cli) usageError() error {
+	fmt.Fprint(c.stderr, usage)
+	return errUsage
+}
+
+// flags returns a flag set for a subcommand, printing its errors and defaults to stderr.
+func (c *cli) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	return fs
+}
+
+// parseWithBotID parses the flags of a subcommand taking a single bot ID argument.
+func (c *cli) parseWithBotID(fs *flag.FlagSet, args []string) (string, error) {
+	if err := fs.Parse(args); err != nil {
+		return "", errUsage
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintf(c.stderr, "usage: recallai %s [flags] BOT_ID\n", fs.Name())
+		return "", errUsage
+	}
+	return fs.Arg(0), nil
+}
+
+// config holds the credentials of the CLI.
+type config struct {
+	APIKey        string `json:"api_key"`
+	Region        string `json:"region"`
+	BaseURL       string `json:"base_url"`
+	WebhookSecret string `json:"webhook_secret"`
+}
+
+// loadConfig reads the config file, if any, and overrides it with the environment.
+func (c *cli) loadConfig() (*config, error) {
+	var cfg config
+
+	path := c.getenv("RECALLAI_CONFIG")
+	if path == "" {
+		if dir, err := os.UserConfigDir(); err == nil {
+			path = filepath.Join(dir, "recallai", "config.json")
+		}
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+		case err != nil:
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		default:
+			if err := json.Unmarshal(data, &cfg); err != nil {
+				return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+			}
+		}
+	}
+
+	for key, field := range map[string]*string{
+		"RECALLAI_API_KEY":        &cfg.APIKey,
+		"RECALLAI_REGION":         &cfg.Region,
+		"RECALLAI_BASE_URL":       &cfg.BaseURL,
+		"RECALLAI_WEBHOOK_SECRET": &cfg.WebhookSecret,
+	} {
+		if value := c.getenv(key); value != "" {
+			*field = value
+		}
+	}
+	return &cfg, nil
+}
+
+// client returns an API client for the configured account.
+func (c *cli) client() (*recallaigo.Client, error) {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.APIKey == "" {
+		return nil, errors.New("no API key: set RECALLAI_API_KEY or api_key in the config file")
+	}
+
+	var opts []recallaigo.ClientOption
+	if cfg.Region != "" {
+		opts = append(opts, recallaigo.WithRegion(recallaigo.Region(cfg.Region)))
+	}
+	if cfg.BaseURL != "" {
+		opts = append(opts, recallaigo.WithBaseURL(cfg.BaseURL))
+	}
+	return recallaigo.NewClient(cfg.APIKey, opts...), nil
+}
+
+// printJSON writes v as indented JSON.
+func (c *cli) printJSON(v any) error {
+	enc := json.NewEncoder(c.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
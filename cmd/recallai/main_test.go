@@ -0,0 +1,173 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+func newTestCLI(env map[string]string) (*cli, *strings.Builder) {
+	var stdout strings.Builder
+	return &cli{
+		stdout: &stdout,
+		stderr: &strings.Builder{},
+		getenv: func(key string) string { return env[key] },
+	}, &stdout
+}
+
+func TestCLI(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+
+	output := filepath.Join(t.TempDir(), "audio.mp3")
+	env := map[string]string{
+		"RECALLAI_CONFIG":   filepath.Join(t.TempDir(), "missing.json"),
+		"RECALLAI_API_KEY":  "some_token",
+		"RECALLAI_BASE_URL": srv.URL,
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantOutput string
+		wantRoute  string
+	}{
+		{
+			name:       "bot create",
+			args:       []string{"bot", "create", "-meeting-url", "https://zoom.us/j/123", "-join-in", "1h"},
+			wantOutput: `"id":`,
+			wantRoute:  recalltest.RouteCreateBot,
+		},
+		{
+			name:       "bot list",
+			args:       []string{"bot", "list", "-status", "done"},
+			wantOutput: "ID",
+			wantRoute:  recalltest.RouteListBots,
+		},
+		{
+			name:       "bot get",
+			args:       []string{"bot", "get", "some_id"},
+			wantOutput: `"id":`,
+			wantRoute:  recalltest.RouteRetrieveBot,
+		},
+		{
+			name:       "bot leave",
+			args:       []string{"bot", "leave", "some_id"},
+			wantOutput: `"id":`,
+			wantRoute:  recalltest.RouteRemoveBotFromCall,
+		},
+		{
+			name:       "transcript get as SRT",
+			args:       []string{"transcript", "get", "-format", "srt", "some_id"},
+			wantOutput: " --> ",
+			wantRoute:  recalltest.RouteGetBotTranscript,
+		},
+		{
+			name:      "media download",
+			args:      []string{"media", "download", "-kind", "audio", "-o", output, "some_id"},
+			wantRoute: recalltest.RouteMedia,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, stdout := newTestCLI(env)
+			if err := c.run(context.Background(), tt.args); err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+			if !strings.Contains(stdout.String(), tt.wantOutput) {
+				t.Errorf("output = %q, want it to contain %q", stdout.String(), tt.wantOutput)
+			}
+
+			requests := srv.Requests()
+			if route := requests[len(requests)-1].Route; route != tt.wantRoute {
+				t.Errorf("last request route = %s, want %s", route, tt.wantRoute)
+			}
+		})
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil || string(data) != recalltest.MediaContent {
+		t.Errorf("downloaded file = %q, %v, want %q", data, err, recalltest.MediaContent)
+	}
+}
+
+func TestCLIMediaDownloadFailure(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+	srv.SetResponse(recalltest.RouteMedia, recalltest.Response{StatusCode: http.StatusInternalServerError})
+
+	output := filepath.Join(t.TempDir(), "audio.mp3")
+	c, _ := newTestCLI(map[string]string{
+		"RECALLAI_CONFIG":   filepath.Join(t.TempDir(), "missing.json"),
+		"RECALLAI_API_KEY":  "some_token",
+		"RECALLAI_BASE_URL": srv.URL,
+	})
+	if err := c.run(context.Background(), []string{"media", "download", "-kind", "audio", "-o", output, "some_id"}); err == nil {
+		t.Fatal("run() error = nil, want an error")
+	}
+	if _, err := os.Stat(output); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("failed download left %s behind: %v", output, err)
+	}
+}
+
+func TestCLIErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		wantErr error
+	}{
+		{
+			name:    "unknown command",
+			args:    []string{"bot", "explode"},
+			wantErr: errUsage,
+		},
+		{
+			name:    "missing bot ID",
+			args:    []string{"bot", "get"},
+			wantErr: errUsage,
+		},
+		{
+			name: "missing API key",
+			args: []string{"bot", "get", "some_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := map[string]string{"RECALLAI_CONFIG": filepath.Join(t.TempDir(), "missing.json")}
+			c, _ := newTestCLI(env)
+
+			err := c.run(context.Background(), tt.args)
+			if err == nil {
+				t.Fatal("run() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("run() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"api_key": "from_file", "region": "eu-central-1"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, _ := newTestCLI(map[string]string{"RECALLAI_CONFIG": path, "RECALLAI_API_KEY": "from_env"})
+	cfg, err := c.loadConfig()
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if cfg.APIKey != "from_env" || cfg.Region != "eu-central-1" {
+		t.Errorf("loadConfig() = %+v, want the API key of the environment and the region of the file", cfg)
+	}
+}
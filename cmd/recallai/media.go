@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func (c *cli) mediaDownload(ctx context.Context, args []string) error {
+	fs := c.flags("media download")
+	kind := fs.String("kind", "video", "the media to download: video or audio")
+	output := fs.String("o", "", `the file to write, "-" for stdout; defaults to BOT_ID.mp4 or BOT_ID.mp3`)
+	botID, err := c.parseWithBotID(fs, args)
+	if err != nil {
+		return err
+	}
+	if *kind != "video" && *kind != "audio" {
+		return fmt.Errorf("unknown media kind %q", *kind)
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	bot, err := client.Bot.RetrieveBot(ctx, botID)
+	if err != nil {
+		return err
+	}
+
+	url, err := mediaURL(ctx, client, bot, *kind)
+	if err != nil {
+		return err
+	}
+
+	if *output == "-" {
+		_, err = client.Media.Download(ctx, url, c.stdout)
+		return err
+	}
+	name := *output
+	if name == "" {
+		name = botID + map[string]string{"video": ".mp4", "audio": ".mp3"}[*kind]
+	}
+	// DownloadFile only creates the file once the download completed, so failures leave no partial file.
+	return client.Media.DownloadFile(ctx, url, name)
+}
+
+// mediaURL returns the download URL of the video or the mixed audio of the bot.
+func mediaURL(ctx context.Context, client *recallaigo.Client, bot *recallaigo.Bot, kind string) (string, error) {
+	if kind == "video" {
+		if bot.VideoURL == "" {
+			return "", errors.New("the bot has no video")
+		}
+		return bot.VideoURL, nil
+	}
+
+	recording := bot.LatestRecording()
+	if recording == nil {
+		return "", errors.New("the bot has no recording")
+	}
+	list, err := client.Media.ListAudioMixed(ctx, &recallaigo.ListAudioMixedParams{RecordingID: recording.ID})
+	if err != nil {
+		return "", err
+	}
+	for _, audio := range list.Results {
+		if audio.Data.DownloadURL != "" {
+			return audio.Data.DownloadURL, nil
+		}
+	}
+	return "", fmt.Errorf("no mixed audio available for recording %s", recording.ID)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func (c *cli) transcriptGet(ctx context.Context, args []string) error {
+	fs := c.flags("transcript get")
+	format := fs.String("format", "text", "the output format: text, srt, json or jsonl")
+	botID, err := c.parseWithBotID(fs, args)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.client()
+	if err != nil {
+		return err
+	}
+	transcript, err := client.Bot.GetBotTranscript(ctx, botID)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "text":
+		return recallaigo.RenderTranscriptText(c.stdout, transcript, recallaigo.TextRenderOptions{Timestamps: true, MergeConsecutive: true})
+	case "srt":
+		return recallaigo.RenderTranscriptSRT(c.stdout, transcript)
+	case "json":
+		return c.printJSON(transcript)
+	case "jsonl":
+		return recallaigo.WriteTranscriptJSONL(c.stdout, transcript)
+	}
+	return fmt.Errorf("unknown format %q", *format)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/harrison-peng/recallai-go/webhook"
+)
+
+func (c *cli) webhookListen(ctx context.Context, args []string) error {
+	fs := c.flags("webhook listen")
+	addr := fs.String("addr", ":8080", "the address to listen on")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.WebhookSecret == "" {
+		return errors.New("no webhook secret: set RECALLAI_WEBHOOK_SECRET or webhook_secret in the config file")
+	}
+	// During a rotation, the current secret is followed by the previous ones, separated by commas.
+	secrets := strings.Split(cfg.WebhookSecret, ",")
+	verifier, err := webhook.NewVerifier(secrets[0], webhook.VerifierOptions{PreviousSecrets: secrets[1:]})
+	if err != nil {
+		return err
+	}
+
+	// Print every verified event as a line of JSON.
+	var mu sync.Mutex
+	enc := json.NewEncoder(c.stdout)
+	handler := verifier.Handler(func(ctx context.Context, event *webhook.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return enc.Encode(event)
+	})
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stderr, "listening for webhooks on %s\n", ln.Addr())
+
+	srv := &http.Server{Handler: handler}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+	if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
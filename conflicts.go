@@ -0,0 +1,85 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ScheduleConflictError is returned by CreateBotWithoutConflicts when other bots already join
+// the meeting of the request in an overlapping window.
+type ScheduleConflictError struct {
+	MeetingURL string
+	Conflicts  []Bot
+}
+
+func (e *ScheduleConflictError) Error() string {
+	ids := make([]string, len(e.Conflicts))
+	for i, bot := range e.Conflicts {
+		ids[i] = bot.ID
+	}
+	return fmt.Sprintf("meeting %s is already scheduled for bots %s", e.MeetingURL, strings.Join(ids, ", "))
+}
+
+// ConflictOptions configures the detection of scheduling conflicts.
+type ConflictOptions struct {
+	// How long a bot is assumed to stay in the meeting after it joins. Bots joining the same
+	// meeting less than Window apart conflict. Defaults to 1 hour.
+	Window time.Duration
+	// The clock giving the join time of requests without JoinAt. Defaults to SystemClock.
+	Clock Clock
+}
+
+// FindScheduleConflicts returns the scheduled bots that join the meeting of the request in a window
+// overlapping the one of the bot it would create, e.g. a bot scheduled twice for the same calendar
+// event. Bots in a terminal status are ignored.
+func FindScheduleConflicts(ctx context.Context, bots BotService, request *CreateBotRequest, opts ...ConflictOptions) ([]Bot, error) {
+	var opt ConflictOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Window <= 0 {
+		opt.Window = time.Hour
+	}
+	if opt.Clock == nil {
+		opt.Clock = SystemClock{}
+	}
+	if request == nil || request.MeetingURL == "" {
+		return nil, errors.New("meeting URL is required")
+	}
+
+	joinAt := opt.Clock.Now()
+	if request.JoinAt != nil {
+		joinAt = *request.JoinAt
+	}
+
+	var conflicts []Bot
+	err := forEachBot(ctx, bots, ListBotsParams{MeetingURL: request.MeetingURL}, func(_ int, bot *Bot) error {
+		if bot.JoinAt == nil || bot.CurrentStatus().IsTerminal() || !sameMeeting(bot.MeetingURL, request.MeetingURL) {
+			return nil
+		}
+		if gap := bot.JoinAt.Sub(joinAt).Abs(); gap < opt.Window {
+			conflicts = append(conflicts, *bot)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
+
+// CreateBotWithoutConflicts creates the bot of the request unless FindScheduleConflicts finds
+// conflicting bots, in which case it returns a *ScheduleConflictError.
+func CreateBotWithoutConflicts(ctx context.Context, bots BotService, request *CreateBotRequest, opts ...ConflictOptions) (*Bot, error) {
+	conflicts, err := FindScheduleConflicts(ctx, bots, request, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, &ScheduleConflictError{MeetingURL: request.MeetingURL, Conflicts: conflicts}
+	}
+	return bots.CreateBot(ctx, request)
+}
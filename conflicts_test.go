@@ -0,0 +1,86 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+const scheduledBots = `{"next": null, "results": [
+	{"id": "scheduled", "meeting_url": {"meeting_id": "123", "platform": "zoom"}, "join_at": "2025-03-20T12:00:00Z"},
+	{"id": "done", "meeting_url": {"meeting_id": "123", "platform": "zoom"}, "join_at": "2025-03-20T12:10:00Z",
+		"status_changes": [{"code": "done", "created_at": "2025-03-20T13:00:00Z"}]},
+	{"id": "other", "meeting_url": {"meeting_id": "456", "platform": "zoom"}, "join_at": "2025-03-20T12:00:00Z"}
+]}`
+
+func TestCreateBotWithoutConflicts(t *testing.T) {
+	now := time.Date(2025, 3, 20, 11, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		joinAt        *time.Time
+		wantConflicts []string
+	}{
+		{
+			name:          "overlapping window",
+			joinAt:        recallaigo.ScheduleAt(time.Date(2025, 3, 20, 12, 30, 0, 0, time.UTC)),
+			wantConflicts: []string{"scheduled"},
+		},
+		{
+			name:          "join now",
+			wantConflicts: []string{"scheduled"},
+		},
+		{
+			name:   "separate window",
+			joinAt: recallaigo.ScheduleAt(time.Date(2025, 3, 20, 13, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			router := testutil.NewRouter()
+			router.HandleString("GET /api/v1/bot", scheduledBots, http.StatusOK)
+			router.Handle("POST /api/v1/bot", func(*http.Request) *http.Response {
+				created = true
+				return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+			request := &recallaigo.CreateBotRequest{MeetingURL: "https://zoom.us/j/123", BotName: "Notetaker", JoinAt: tt.joinAt}
+			_, err := recallaigo.CreateBotWithoutConflicts(context.Background(), client.Bot, request,
+				recallaigo.ConflictOptions{Clock: recalltest.NewFakeClock(now)})
+
+			var conflictErr *recallaigo.ScheduleConflictError
+			if len(tt.wantConflicts) == 0 {
+				if err != nil {
+					t.Fatalf("CreateBotWithoutConflicts() error = %v", err)
+				}
+				if !created {
+					t.Error("CreateBotWithoutConflicts() did not create the bot")
+				}
+				return
+			}
+			if !errors.As(err, &conflictErr) {
+				t.Fatalf("CreateBotWithoutConflicts() error = %v, want a *ScheduleConflictError", err)
+			}
+			var ids []string
+			for _, bot := range conflictErr.Conflicts {
+				ids = append(ids, bot.ID)
+			}
+			if !slices.Equal(ids, tt.wantConflicts) {
+				t.Errorf("CreateBotWithoutConflicts() conflicts = %v, want %v", ids, tt.wantConflicts)
+			}
+			if created {
+				t.Error("CreateBotWithoutConflicts() created a conflicting bot")
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package recallaigo
+
+import "sort"
+
+// IntelligenceKeyGladiaModeration is the key of the Gladia moderation result in the intelligence response.
+const IntelligenceKeyGladiaModeration = "gladia.moderation"
+
+// GladiaIntelligence holds the results of the Gladia audio intelligence features.
+// see https://docs.gladia.io/chapters/audio-intelligence/pages/moderation
+type GladiaIntelligence struct {
+	Moderation *GladiaModeration
+}
+
+// GladiaModeration is the result of Gladia content moderation.
+type GladiaModeration struct {
+	Success bool                     `json:"success"`
+	IsEmpty bool                     `json:"is_empty"`
+	Results []GladiaModerationResult `json:"results"`
+}
+
+// GladiaModerationResult is a section of the recording with sensitive content.
+type GladiaModerationResult struct {
+	Text  string `json:"text"`
+	Label string `json:"label"`
+	// The severity between 0 and 1.
+	Severity float64 `json:"severity"`
+	// The start time in seconds.
+	Start float64 `json:"start"`
+	// The end time in seconds.
+	End float64 `json:"end"`
+}
+
+// ContentSafetyFlag is a section of the recording flagged as sensitive content,
+// independent of the provider that flagged it.
+type ContentSafetyFlag struct {
+	// The kind of sensitive content, e.g. "profanity".
+	Label string
+	// The severity between 0 and 1.
+	Severity float64
+	// The confidence of the label between 0 and 1, or 0 if the provider does not report one.
+	Confidence float64
+	Text       string
+	// The start time in seconds since the start of the recording.
+	Start float64
+	// The end time in seconds since the start of the recording.
+	End float64
+}
+
+// ContentSafetyFlags returns the sensitive content flagged by any provider, ordered by start time.
+// A section with several labels yields one flag per label.
+func (r *IntelligenceResult) ContentSafetyFlags() []ContentSafetyFlag {
+	var flags []ContentSafetyFlag
+	if r.AssemblyAI != nil && r.AssemblyAI.ContentSafety != nil {
+		for _, result := range r.AssemblyAI.ContentSafety.Results {
+			for _, label := range result.Labels {
+				flags = append(flags, ContentSafetyFlag{
+					Label:      label.Label,
+					Severity:   label.Severity,
+					Confidence: label.Confidence,
+					Text:       result.Text,
+					Start:      float64(result.Timestamp.Start) / 1000,
+					End:        float64(result.Timestamp.End) / 1000,
+				})
+			}
+		}
+	}
+	if r.Gladia != nil && r.Gladia.Moderation != nil {
+		for _, result := range r.Gladia.Moderation.Results {
+			flags = append(flags, ContentSafetyFlag{
+				Label:    result.Label,
+				Severity: result.Severity,
+				Text:     result.Text,
+				Start:    result.Start,
+				End:      result.End,
+			})
+		}
+	}
+
+	sort.SliceStable(flags, func(i, j int) bool {
+		return flags[i].Start < flags[j].Start
+	})
+	return flags
+}
+
+// ContentSafetyPolicy maps labels to the highest severity allowed for them.
+// Labels that are not in the policy are allowed at any severity.
+type ContentSafetyPolicy map[string]float64
+
+// Violations returns the flags whose severity exceeds the policy.
+func (p ContentSafetyPolicy) Violations(flags []ContentSafetyFlag) []ContentSafetyFlag {
+	var violations []ContentSafetyFlag
+	for _, flag := range flags {
+		if maxSeverity, ok := p[flag.Label]; ok && flag.Severity > maxSeverity {
+			violations = append(violations, flag)
+		}
+	}
+	return violations
+}
@@ -0,0 +1,64 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestContentSafetyFlags(t *testing.T) {
+	c := testutil.NewMockedClient(t, "test_data/get_bot_intelligence.json", http.StatusOK)
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	result, err := client.Bot.GetBotIntelligence(context.Background(), "some_id")
+	if err != nil {
+		t.Fatalf("GetBotIntelligence() error = %v", err)
+	}
+
+	flags := result.ContentSafetyFlags()
+	want := []recallaigo.ContentSafetyFlag{
+		{Label: "harassment", Severity: 0.6, Text: "shut up", Start: 20.5, End: 21.2},
+		{Label: "profanity", Severity: 0.12, Confidence: 0.91, Text: "That was a damn good demo.", Start: 45, End: 47},
+	}
+	if len(flags) != len(want) {
+		t.Fatalf("ContentSafetyFlags() = %+v, want %+v", flags, want)
+	}
+	for i := range flags {
+		if flags[i] != want[i] {
+			t.Errorf("ContentSafetyFlags()[%d] = %+v, want %+v", i, flags[i], want[i])
+		}
+	}
+
+	tests := []struct {
+		name   string
+		policy recallaigo.ContentSafetyPolicy
+		want   []string
+	}{
+		{
+			name:   "reports labels above their severity",
+			policy: recallaigo.ContentSafetyPolicy{"harassment": 0.5, "profanity": 0.5},
+			want:   []string{"harassment"},
+		},
+		{
+			name:   "allows labels outside the policy",
+			policy: recallaigo.ContentSafetyPolicy{"hate_speech": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := tt.policy.Violations(flags)
+			if len(violations) != len(tt.want) {
+				t.Fatalf("Violations() = %+v, want labels %v", violations, tt.want)
+			}
+			for i, label := range tt.want {
+				if violations[i].Label != label {
+					t.Errorf("Violations()[%d] = %q, want %q", i, violations[i].Label, label)
+				}
+			}
+		})
+	}
+}
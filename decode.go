@@ -0,0 +1,196 @@
+package recallaigo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeWarning describes a response field that tolerant decoding skipped because its value
+// did not match the type of the field, e.g. a string where a number was expected.
+//
+// Only the earliest mismatch of a response is reported, as the JSON decoder keeps no other;
+// later mismatched fields are skipped all the same. For a mismatch inside a type with its own
+// JSON decoding, such as Time or MeetingURL, Field is empty and Offset is relative to the value
+// of that type rather than to the body.
+type DecodeWarning struct {
+	// The URL path of the request, if known.
+	Path string
+	// The dotted path of the field, e.g. "results.0.status_changes.1.code", or empty if the
+	// mismatch is inside a type with its own JSON decoding.
+	Field string
+	// The kind of the JSON value, e.g. "string" or "number".
+	Value string
+	// The Go type of the field.
+	Type string
+	// The byte offset of the value in the response body, or in the value of a type with its
+	// own JSON decoding if Field is empty.
+	Offset int64
+}
+
+// WithTolerantDecoding makes the client skip response fields whose value does not match their type
+// instead of failing the whole call, e.g. when the API changes the type of a field. The rest of the
+// response is decoded as usual, and onWarning is called with the earliest skipped field of every
+// response; see DecodeWarning for what it can tell. onWarning may be nil.
+func WithTolerantDecoding(onWarning func(DecodeWarning)) ClientOption {
+	return func(c *Client) {
+		if onWarning == nil {
+			onWarning = func(DecodeWarning) {}
+		}
+		c.onDecodeWarning = onWarning
+	}
+}
+
+// tolerate returns nil instead of a type mismatch error if tolerant decoding is enabled,
+// reporting the skipped field. The JSON decoder keeps decoding after such errors, so the
+// value holds the rest of the response.
+func (c *Client) tolerate(res *http.Response, err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if c.onDecodeWarning == nil || !errors.As(err, &typeErr) {
+		return err
+	}
+
+	warning := DecodeWarning{
+		Field:  typeErr.Field,
+		Value:  typeErr.Value,
+		Offset: typeErr.Offset,
+	}
+	if typeErr.Type != nil {
+		warning.Type = typeErr.Type.String()
+	}
+	if res != nil && res.Request != nil {
+		warning.Path = res.Request.URL.Path
+	}
+	c.onDecodeWarning(warning)
+
+	return nil
+}
+
+// fieldsChecker is implemented by the types with their own JSON decoding whose fields strict
+// decoding checks, as the JSON decoder does not check the fields of such types itself.
+type fieldsChecker interface {
+	checkFields(data []byte, path string) error
+}
+
+var (
+	fieldsCheckerType = reflect.TypeFor[fieldsChecker]()
+	unmarshalerType   = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// checkFields returns an error for the first object key in data that no field of t decodes,
+// including the keys inside types with their own JSON decoding that implement fieldsChecker.
+// Values that do not match t are left to the decoding itself, and the fields of other types with
+// their own JSON decoding, such as Metadata, are not checked.
+func checkFields(data []byte, t reflect.Type, path string) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if pt := reflect.PointerTo(t); pt.Implements(fieldsCheckerType) {
+		return reflect.New(t).Interface().(fieldsChecker).checkFields(data, path)
+	} else if pt.Implements(unmarshalerType) {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			return nil
+		}
+		fields := jsonFields(t)
+		for key, value := range object {
+			field, ok := fields[key]
+			if !ok {
+				field, ok = foldedField(fields, key)
+			}
+			if !ok {
+				return fmt.Errorf("json: unknown field %q", joinPath(path, key))
+			}
+			if err := checkFields(value, field, joinPath(path, key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var elems []json.RawMessage
+		if t.Elem().Kind() == reflect.Uint8 || json.Unmarshal(data, &elems) != nil {
+			return nil
+		}
+		for i, elem := range elems {
+			if err := checkFields(elem, t.Elem(), joinPath(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		var values map[string]json.RawMessage
+		if json.Unmarshal(data, &values) != nil {
+			return nil
+		}
+		for key, value := range values {
+			if err := checkFields(value, t.Elem(), joinPath(path, key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the types of the fields of a struct by their JSON names, including those
+// promoted from embedded structs unless a shallower field has the same name.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	var embedded []reflect.Type
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	for _, et := range embedded {
+		for name, ft := range jsonFields(et) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+	return fields
+}
+
+// foldedField returns the field whose name matches key case-insensitively, as the JSON decoder
+// accepts such keys too.
+func foldedField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
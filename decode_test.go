@@ -0,0 +1,92 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestTolerantDecoding(t *testing.T) {
+	const page = `{"next": null, "results": [{"id": "first", "bot_name": 42}, {"id": "second", "bot_name": "Notetaker"}]}`
+
+	tests := []struct {
+		name         string
+		tolerant     bool
+		wantErr      bool
+		wantWarnings int
+	}{
+		{
+			name:    "fails on a mismatched field by default",
+			wantErr: true,
+		},
+		{
+			name:         "skips mismatched fields when tolerant",
+			tolerant:     true,
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				res := testutil.NewStringResponse(page, http.StatusOK)
+				res.Request = req
+				return res
+			})
+			opts := []recallaigo.ClientOption{recallaigo.WithHTTPClient(c)}
+
+			var warnings []recallaigo.DecodeWarning
+			if tt.tolerant {
+				opts = append(opts, recallaigo.WithTolerantDecoding(func(w recallaigo.DecodeWarning) {
+					warnings = append(warnings, w)
+				}))
+			}
+			client := recallaigo.NewClient("some_token", opts...)
+
+			got, err := client.Bot.ListBots(context.Background(), nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListBots() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Fatalf("warnings = %+v, want %d", warnings, tt.wantWarnings)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got.Results) != 2 || got.Results[1].BotName != "Notetaker" {
+				t.Errorf("ListBots() = %+v, want both bots", got.Results)
+			}
+			want := recallaigo.DecodeWarning{Path: "/api/v1/bot", Field: "results.0.bot_name", Value: "number", Type: "string"}
+			warnings[0].Offset = 0
+			if warnings[0] != want {
+				t.Errorf("warning = %+v, want %+v", warnings[0], want)
+			}
+		})
+	}
+}
+
+func TestTolerantDecodingCustomTypes(t *testing.T) {
+	c := testutil.NewTestClient(func(*http.Request) *http.Response {
+		return testutil.NewStringResponse(`{"id": "bot_id", "join_at": 42, "bot_name": 42, "video_url": "https://example.com/video.mp4"}`, http.StatusOK)
+	})
+	var warnings []recallaigo.DecodeWarning
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithTolerantDecoding(func(w recallaigo.DecodeWarning) {
+		warnings = append(warnings, w)
+	}))
+
+	bot, err := client.Bot.RetrieveBot(context.Background(), "bot_id")
+	if err != nil {
+		t.Fatalf("RetrieveBot() error = %v", err)
+	}
+	if bot.VideoURL != "https://example.com/video.mp4" {
+		t.Errorf("VideoURL = %q, want the rest of the response", bot.VideoURL)
+	}
+	// Only the mismatch inside Time is reported, without a field
+	if len(warnings) != 1 || warnings[0].Field != "" || warnings[0].Type != "string" {
+		t.Errorf("warnings = %+v, want one without a field", warnings)
+	}
+}
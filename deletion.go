@@ -0,0 +1,227 @@
+package recallaigo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrDeletionIncomplete is returned with the reports of deletions that failed for some bots.
+var ErrDeletionIncomplete = errors.New("deletion incomplete")
+
+// DeletionAction is what a DataDeleter did with the data of a bot.
+type DeletionAction string
+
+const (
+	// The bot had not joined its call yet and was deleted.
+	DeletionScheduledBotDeleted DeletionAction = "scheduled_bot_deleted"
+	// The media of the bot was deleted and has expired.
+	DeletionMediaDeleted DeletionAction = "media_deleted"
+	// The media of the bot had already expired.
+	DeletionMediaAlreadyExpired DeletionAction = "media_already_expired"
+	// The bot does not exist, e.g. because it was already deleted.
+	DeletionBotNotFound DeletionAction = "bot_not_found"
+	// The data of the bot could not be deleted, see the error of the deletion.
+	DeletionFailed DeletionAction = "failed"
+)
+
+// BotDeletion records the deletion of the data of a bot.
+type BotDeletion struct {
+	BotID  string         `json:"bot_id"`
+	Action DeletionAction `json:"action"`
+	// The status of the bot once its data was deleted, if it still exists.
+	Status      Status    `json:"status,omitempty"`
+	CompletedAt time.Time `json:"completed_at"`
+	Error       string    `json:"error,omitempty"`
+}
+
+// DeletionReport is the record of a data deletion, e.g. for a GDPR data subject request.
+// It is signed with HMAC-SHA256, so that Verify detects changes made without the signing key.
+// The key is symmetric: anyone able to verify a report can also forge one, so the signature is
+// an integrity check of reports kept by the holder of the key, not evidence towards third parties.
+type DeletionReport struct {
+	// What was deleted, e.g. "bot:<id>" or "metadata:customer_id=42".
+	Subject     string        `json:"subject"`
+	StartedAt   time.Time     `json:"started_at"`
+	CompletedAt time.Time     `json:"completed_at"`
+	Bots        []BotDeletion `json:"bots"`
+	// Whether the data of every bot was deleted.
+	Complete bool `json:"complete"`
+	// The hex-encoded HMAC-SHA256 of the JSON encoding of the report without its signature.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Verify reports whether the report was signed with the key and not changed since.
+func (r *DeletionReport) Verify(key []byte) bool {
+	want, err := r.sign(key)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(r.Signature), []byte(want))
+}
+
+func (r *DeletionReport) sign(key []byte) (string, error) {
+	unsigned := *r
+	unsigned.Signature = ""
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// DataDeleterOptions configures a DataDeleter.
+type DataDeleterOptions struct {
+	// The key signing the reports. Required.
+	SigningKey []byte
+	// The interval between checks while waiting for deleted media to expire. Defaults to 10 seconds.
+	PollInterval time.Duration
+	// The clock of the polling and of the report times. Defaults to SystemClock.
+	Clock Clock
+}
+
+// DataDeleter deletes the data of bots and produces signed reports of the deletions:
+// scheduled bots are deleted, and the media of the others is deleted and awaited until the bot
+// is media_expired. Bots still in their call are not removed from it; their deletion fails.
+type DataDeleter struct {
+	bots BotService
+	opts DataDeleterOptions
+}
+
+// NewDataDeleter creates a DataDeleter that uses the given bot service.
+func NewDataDeleter(bots BotService, opts DataDeleterOptions) (*DataDeleter, error) {
+	if len(opts.SigningKey) == 0 {
+		return nil, errors.New("signing key is required")
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.Clock == nil {
+		opts.Clock = SystemClock{}
+	}
+
+	return &DataDeleter{bots: bots, opts: opts}, nil
+}
+
+// DeleteBot deletes the data of a bot. The report is returned even if the deletion failed,
+// together with ErrDeletionIncomplete.
+func (d *DataDeleter) DeleteBot(ctx context.Context, botID string) (*DeletionReport, error) {
+	return d.delete(ctx, "bot:"+botID, []string{botID})
+}
+
+// DeleteByMetadata deletes the data of every bot whose metadata has the given string value
+// for the key, e.g. the ID of a customer. The report is returned even if the deletion failed
+// for some bots, together with ErrDeletionIncomplete.
+func (d *DataDeleter) DeleteByMetadata(ctx context.Context, key, value string) (*DeletionReport, error) {
+	bots, err := ListBotsByMetadata(ctx, d.bots, map[string]string{key: value})
+	if err != nil {
+		return nil, err
+	}
+	botIDs := make([]string, 0, len(bots))
+	for _, bot := range bots {
+		botIDs = append(botIDs, bot.ID)
+	}
+
+	return d.delete(ctx, fmt.Sprintf("metadata:%s=%s", key, value), botIDs)
+}
+
+func (d *DataDeleter) delete(ctx context.Context, subject string, botIDs []string) (*DeletionReport, error) {
+	report := &DeletionReport{
+		Subject:   subject,
+		StartedAt: d.opts.Clock.Now().UTC(),
+		Bots:      []BotDeletion{},
+		Complete:  true,
+	}
+	for _, botID := range botIDs {
+		deletion := d.deleteBot(ctx, botID)
+		if deletion.Action == DeletionFailed {
+			report.Complete = false
+		}
+		report.Bots = append(report.Bots, deletion)
+	}
+	report.CompletedAt = d.opts.Clock.Now().UTC()
+
+	signature, err := report.sign(d.opts.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign report: %w", err)
+	}
+	report.Signature = signature
+
+	if !report.Complete {
+		return report, ErrDeletionIncomplete
+	}
+	return report, nil
+}
+
+func (d *DataDeleter) deleteBot(ctx context.Context, botID string) BotDeletion {
+	deletion := BotDeletion{BotID: botID}
+	action, status, err := d.deleteBotData(ctx, botID)
+	if err != nil {
+		action, deletion.Error = DeletionFailed, err.Error()
+	}
+	deletion.Action, deletion.Status = action, status
+	deletion.CompletedAt = d.opts.Clock.Now().UTC()
+	return deletion
+}
+
+func (d *DataDeleter) deleteBotData(ctx context.Context, botID string) (DeletionAction, Status, error) {
+	bot, err := d.bots.RetrieveBot(ctx, botID)
+	if IsNotFound(err) {
+		return DeletionBotNotFound, "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	status := bot.CurrentStatus()
+	switch {
+	case bot.isScheduled():
+		if err := d.bots.DeleteScheduledBot(ctx, botID); err != nil {
+			return "", status, err
+		}
+		return DeletionScheduledBotDeleted, "", nil
+	case status == StatusMediaExpired:
+		return DeletionMediaAlreadyExpired, status, nil
+	case !status.IsTerminal():
+		return "", status, fmt.Errorf("bot is still active in status %s", status)
+	}
+
+	if err := d.bots.DeleteBotMedia(ctx, botID); err != nil {
+		return "", status, err
+	}
+	if err := d.waitForMediaExpired(ctx, botID); err != nil {
+		return "", status, fmt.Errorf("failed to wait for the media to expire: %w", err)
+	}
+	return DeletionMediaDeleted, StatusMediaExpired, nil
+}
+
+// waitForMediaExpired polls the bot until it is media_expired. Unlike WaitForStatus, it keeps
+// waiting while the bot is in another terminal status, such as done.
+func (d *DataDeleter) waitForMediaExpired(ctx context.Context, botID string) error {
+	ticker := d.opts.Clock.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		bot, err := d.bots.RetrieveBot(ctx, botID)
+		if err != nil {
+			return err
+		}
+		if bot.CurrentStatus() == StatusMediaExpired {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestDataDeleter(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+
+	clock := recalltest.NewFakeClock(time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC))
+	srv.PlayScenario(clock, "done", recalltest.Step{Status: recallaigo.StatusDone},
+		recalltest.Step{At: time.Minute, Status: recallaigo.StatusMediaExpired})
+	srv.PlayScenario(clock, "expired", recalltest.Step{Status: recallaigo.StatusMediaExpired})
+	srv.PlayScenario(clock, "recording", recalltest.Step{Status: recallaigo.StatusInCallRecording})
+	// Unscripted bots are scheduled.
+	srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.Response{
+		Body: `{"id": "scheduled", "join_at": "2025-03-19T10:00:00Z", "status_changes": []}`,
+	})
+	srv.SetResponse(recalltest.RouteListBots, recalltest.Response{Body: `{"next": null, "results": [
+		{"id": "done", "metadata": {"customer_id": "42"}},
+		{"id": "other", "metadata": {"customer_id": "7"}},
+		{"id": "expired", "metadata": {"customer_id": "42"}},
+		{"id": "scheduled", "metadata": {"customer_id": "42"}}
+	]}`})
+
+	key := []byte("signing key")
+	deleter, err := recallaigo.NewDataDeleter(srv.NewClient().Bot, recallaigo.DataDeleterOptions{
+		SigningKey:   key,
+		PollInterval: 30 * time.Second,
+		Clock:        clock,
+	})
+	if err != nil {
+		t.Fatalf("NewDataDeleter() error = %v", err)
+	}
+	ctx := context.Background()
+
+	t.Run("deletes the data of bots by metadata", func(t *testing.T) {
+		type result struct {
+			report *recallaigo.DeletionReport
+			err    error
+		}
+		done := make(chan result)
+		go func() {
+			report, err := deleter.DeleteByMetadata(ctx, "customer_id", "42")
+			done <- result{report, err}
+		}()
+
+		// The media of the done bot expires after a minute.
+		clock.BlockUntilTickers(1)
+		clock.Advance(time.Minute)
+
+		var res result
+		select {
+		case res = <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("DeleteByMetadata() did not return after the media expired")
+		}
+		if res.err != nil {
+			t.Fatalf("DeleteByMetadata() error = %v", res.err)
+		}
+
+		report := res.report
+		if report.Subject != "metadata:customer_id=42" || !report.Complete {
+			t.Errorf("report = %+v", report)
+		}
+		want := []struct {
+			botID  string
+			action recallaigo.DeletionAction
+			status recallaigo.Status
+		}{
+			{"done", recallaigo.DeletionMediaDeleted, recallaigo.StatusMediaExpired},
+			{"expired", recallaigo.DeletionMediaAlreadyExpired, recallaigo.StatusMediaExpired},
+			{"scheduled", recallaigo.DeletionScheduledBotDeleted, ""},
+		}
+		if len(report.Bots) != len(want) {
+			t.Fatalf("report has %d bots, want %d", len(report.Bots), len(want))
+		}
+		for i, w := range want {
+			got := report.Bots[i]
+			if got.BotID != w.botID || got.Action != w.action || got.Status != w.status || got.Error != "" {
+				t.Errorf("bot %d = %+v, want %s %s in status %q", i, got, w.botID, w.action, w.status)
+			}
+		}
+		if !report.CompletedAt.Equal(report.StartedAt.Add(time.Minute)) {
+			t.Errorf("report took from %s to %s, want a minute", report.StartedAt, report.CompletedAt)
+		}
+
+		var routes []string
+		for _, req := range srv.Requests() {
+			if req.Route == recalltest.RouteDeleteBotMedia || req.Route == recalltest.RouteDeleteScheduledBot {
+				routes = append(routes, req.Method+" "+req.Path)
+			}
+		}
+		wantRoutes := []string{"POST /api/v1/bot/done/delete_media", "DELETE /api/v1/bot/scheduled"}
+		if len(routes) != 2 || routes[0] != wantRoutes[0] || routes[1] != wantRoutes[1] {
+			t.Errorf("deletions = %v, want %v", routes, wantRoutes)
+		}
+	})
+
+	t.Run("fails for bots in their call", func(t *testing.T) {
+		report, err := deleter.DeleteBot(ctx, "recording")
+		if !errors.Is(err, recallaigo.ErrDeletionIncomplete) {
+			t.Fatalf("DeleteBot() error = %v, want ErrDeletionIncomplete", err)
+		}
+		if report.Complete || report.Bots[0].Action != recallaigo.DeletionFailed || report.Bots[0].Error == "" {
+			t.Errorf("report = %+v", report)
+		}
+		if !report.Verify(key) {
+			t.Error("Verify() = false for the report of a failed deletion")
+		}
+	})
+
+	t.Run("signs reports", func(t *testing.T) {
+		report, err := deleter.DeleteBot(ctx, "expired")
+		if err != nil {
+			t.Fatalf("DeleteBot() error = %v", err)
+		}
+		if !report.Verify(key) {
+			t.Error("Verify() = false for a signed report")
+		}
+		if report.Verify([]byte("other key")) {
+			t.Error("Verify() = true with another key")
+		}
+
+		report.Bots[0].Action = recallaigo.DeletionMediaDeleted
+		if report.Verify(key) {
+			t.Error("Verify() = true for a changed report")
+		}
+	})
+
+	t.Run("records bots not found", func(t *testing.T) {
+		srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.ErrorResponse(http.StatusNotFound))
+		report, err := deleter.DeleteBot(ctx, "deleted")
+		if err != nil {
+			t.Fatalf("DeleteBot() error = %v", err)
+		}
+		if report.Bots[0].Action != recallaigo.DeletionBotNotFound {
+			t.Errorf("action = %s, want %s", report.Bots[0].Action, recallaigo.DeletionBotNotFound)
+		}
+	})
+
+	if _, err := recallaigo.NewDataDeleter(srv.NewClient().Bot, recallaigo.DataDeleterOptions{}); err == nil {
+		t.Error("NewDataDeleter() error = nil without signing key")
+	}
+}
+
+func TestDataDeleterEvictsCachedResponses(t *testing.T) {
+	deleted := false
+	router := testutil.NewRouter()
+	router.Handle("GET /api/v1/bot/{id}", func(*http.Request) *http.Response {
+		status := "done"
+		if deleted {
+			status = "media_expired"
+		}
+		return testutil.NewStringResponse(`{"id": "some_id", "status_changes": [{"code": "`+status+`"}]}`, http.StatusOK)
+	})
+	router.Handle("GET /api/v1/bot/{id}/transcript", func(req *http.Request) *http.Response {
+		if deleted {
+			return testutil.NewStringResponse(`{"detail": "Not found."}`, http.StatusNotFound)
+		}
+		res := testutil.NewFileResponse(t, "test_data/get_bot_transcript.json", http.StatusOK)
+		res.Header.Set("ETag", `"v1"`)
+		return res
+	})
+	router.Handle("POST /api/v1/bot/{id}/delete_media", func(*http.Request) *http.Response {
+		deleted = true
+		return testutil.NewStringResponse("", http.StatusNoContent)
+	})
+
+	store := recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()),
+		recallaigo.WithCache(store), recallaigo.WithETags(store))
+	ctx := context.Background()
+
+	if _, err := client.Bot.RetrieveBot(ctx, "some_id"); err != nil {
+		t.Fatalf("RetrieveBot() error = %v", err)
+	}
+	if _, err := client.Bot.GetBotTranscript(ctx, "some_id"); err != nil {
+		t.Fatalf("GetBotTranscript() error = %v", err)
+	}
+
+	deleter, err := recallaigo.NewDataDeleter(client.Bot, recallaigo.DataDeleterOptions{SigningKey: []byte("key")})
+	if err != nil {
+		t.Fatalf("NewDataDeleter() error = %v", err)
+	}
+	if _, err := deleter.DeleteBot(ctx, "some_id"); err != nil {
+		t.Fatalf("DeleteBot() error = %v", err)
+	}
+
+	if _, err := client.Bot.GetBotTranscript(ctx, "some_id"); !recallaigo.IsNotFound(err) {
+		t.Errorf("GetBotTranscript() after the deletion error = %v, want the 404 of the API", err)
+	}
+	// Only the terminal status of the bot is left.
+	if store.Len() != 1 {
+		t.Errorf("store holds %d entries after the deletion, want 1", store.Len())
+	}
+}
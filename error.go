@@ -1,10 +1,75 @@
 package recallaigo
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is an error response of the API. Failed requests return it wrapped, so that it can be
+// inspected with errors.As or with helpers such as IsNotFound. Well-known failures are wrapped
+// in a dedicated type first, such as *BotNotInCallError.
 type Error struct {
-	Code   string    `json:"code"`
-	Detail string `json:"detail"`
+	// The HTTP status code of the response.
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Detail     string `json:"detail"`
+	// The reason of the failure, given by some endpoints in addition to the code.
+	SubCode string `json:"sub_code"`
+	// The raw body of the response.
+	Body string `json:"-"`
 }
 
 func (e Error) Error() string {
+	if e.Body != "" {
+		return fmt.Sprintf("API request failed: %s", e.Body)
+	}
 	return e.Detail
 }
+
+// newError returns the Error of a response with the given status code and body. The code, detail
+// and sub_code fields are read from JSON bodies when present, whatever their JSON type.
+func newError(statusCode int, body []byte) *Error {
+	e := &Error{StatusCode: statusCode, Body: string(body)}
+
+	var fields struct {
+		Code    json.RawMessage `json:"code"`
+		Detail  json.RawMessage `json:"detail"`
+		SubCode json.RawMessage `json:"sub_code"`
+	}
+	if json.Unmarshal(body, &fields) == nil {
+		e.Code = jsonText(fields.Code)
+		e.Detail = jsonText(fields.Detail)
+		e.SubCode = jsonText(fields.SubCode)
+	}
+	return e
+}
+
+// jsonText returns a JSON string unquoted, and any other JSON value as is.
+func jsonText(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// IsNotFound reports whether err was caused by a 404 Not Found response of the API,
+// e.g. for a bot that was deleted.
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IgnoreNotFound returns nil if err was caused by a 404 Not Found response of the API, and err otherwise.
+// It makes deletions idempotent:
+//
+//	err := recallaigo.IgnoreNotFound(client.Bot.DeleteScheduledBot(ctx, botID))
+func IgnoreNotFound(err error) error {
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
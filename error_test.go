@@ -0,0 +1,72 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		body         string
+		want         recallaigo.Error
+		wantNotFound bool
+	}{
+		{
+			name:       "reads numeric codes",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code": 400, "detail": "test error"}`,
+			want:       recallaigo.Error{StatusCode: http.StatusBadRequest, Code: "400", Detail: "test error"},
+		},
+		{
+			name:         "reads string codes",
+			statusCode:   http.StatusNotFound,
+			body:         `{"code": "not_found", "detail": "Not found."}`,
+			want:         recallaigo.Error{StatusCode: http.StatusNotFound, Code: "not_found", Detail: "Not found."},
+			wantNotFound: true,
+		},
+		{
+			name:       "keeps bodies that are not JSON",
+			statusCode: http.StatusBadGateway,
+			body:       "bad gateway",
+			want:       recallaigo.Error{StatusCode: http.StatusBadGateway},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				return testutil.NewStringResponse(tt.body, tt.statusCode)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+			_, err := client.Bot.RetrieveBot(context.Background(), "bot_id")
+
+			var apiErr *recallaigo.Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("RetrieveBot() error = %v, want an *Error", err)
+			}
+			tt.want.Body = tt.body
+			if *apiErr != tt.want {
+				t.Errorf("error = %+v, want %+v", *apiErr, tt.want)
+			}
+
+			if got := recallaigo.IsNotFound(err); got != tt.wantNotFound {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.wantNotFound)
+			}
+			if got := recallaigo.IgnoreNotFound(err); (got == nil) != tt.wantNotFound {
+				t.Errorf("IgnoreNotFound() = %v", got)
+			}
+		})
+	}
+
+	if recallaigo.IsNotFound(fmt.Errorf("failed: %w", errors.New("not found"))) {
+		t.Error("IsNotFound() = true for an error that is not from the API")
+	}
+}
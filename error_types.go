@@ -0,0 +1,79 @@
+package recallaigo
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// BotNotInCallError is returned for requests that need the bot to be in the call, such as
+// SendChatMessage or OutputAudio, when it has not joined it yet or already left it.
+type BotNotInCallError struct {
+	Err *Error
+}
+
+func (e *BotNotInCallError) Error() string { return e.Err.Error() }
+func (e *BotNotInCallError) Unwrap() error { return e.Err }
+
+// RecordingNotStartedError is returned for requests that need the bot to record, such as
+// PauseRecording, while it is in the call without recording.
+type RecordingNotStartedError struct {
+	Err *Error
+}
+
+func (e *RecordingNotStartedError) Error() string { return e.Err.Error() }
+func (e *RecordingNotStartedError) Unwrap() error { return e.Err }
+
+// MediaExpiredError is returned for requests about media deleted at the end of its retention,
+// or with DeleteBotMedia.
+type MediaExpiredError struct {
+	Err *Error
+}
+
+func (e *MediaExpiredError) Error() string { return e.Err.Error() }
+func (e *MediaExpiredError) Unwrap() error { return e.Err }
+
+// knownError recognizes a well-known failure by the code or sub_code of the response,
+// or by its detail for the endpoints that only describe the failure.
+type knownError struct {
+	statuses []int
+	codes    []string
+	details  []string
+	wrap     func(*Error) error
+}
+
+var knownErrors = []knownError{
+	{
+		statuses: []int{http.StatusBadRequest, http.StatusConflict},
+		codes:    []string{"bot_not_in_call", "cannot_command_unstarted_bot", "cannot_command_completed_bot"},
+		details:  []string{"not in the call", "not in call", "has not joined", "has already left"},
+		wrap:     func(e *Error) error { return &BotNotInCallError{Err: e} },
+	},
+	{
+		statuses: []int{http.StatusBadRequest, http.StatusConflict},
+		codes:    []string{"recording_not_started", "bot_not_recording"},
+		details:  []string{"not recording", "recording has not started"},
+		wrap:     func(e *Error) error { return &RecordingNotStartedError{Err: e} },
+	},
+	{
+		statuses: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusGone},
+		codes:    []string{"media_expired", "media_deleted"},
+		details:  []string{"media has expired", "media expired", "media has been deleted"},
+		wrap:     func(e *Error) error { return &MediaExpiredError{Err: e} },
+	},
+}
+
+// typedError returns the dedicated error of a well-known failure, wrapping e, and e otherwise.
+func typedError(e *Error) error {
+	detail := strings.ToLower(e.Detail)
+	for _, known := range knownErrors {
+		if !slices.Contains(known.statuses, e.StatusCode) {
+			continue
+		}
+		if slices.Contains(known.codes, e.Code) || slices.Contains(known.codes, e.SubCode) ||
+			slices.ContainsFunc(known.details, func(s string) bool { return strings.Contains(detail, s) }) {
+			return known.wrap(e)
+		}
+	}
+	return e
+}
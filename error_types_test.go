@@ -0,0 +1,77 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestTypedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		is         func(error) bool
+	}{
+		{
+			name:       "bot not in call by code",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code": "cannot_command_unstarted_bot", "detail": "Bot has not joined the call yet."}`,
+			is: func(err error) bool {
+				var target *recallaigo.BotNotInCallError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name:       "recording not started by sub code",
+			statusCode: http.StatusConflict,
+			body:       `{"code": "conflict", "sub_code": "recording_not_started", "detail": "Cannot pause."}`,
+			is: func(err error) bool {
+				var target *recallaigo.RecordingNotStartedError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name:       "media expired by detail",
+			statusCode: http.StatusGone,
+			body:       `{"detail": "The media has expired."}`,
+			is: func(err error) bool {
+				var target *recallaigo.MediaExpiredError
+				return errors.As(err, &target)
+			},
+		},
+		{
+			name:       "other failure",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code": 400, "detail": "test error"}`,
+			is: func(err error) bool {
+				var a *recallaigo.BotNotInCallError
+				var b *recallaigo.RecordingNotStartedError
+				var c *recallaigo.MediaExpiredError
+				return !errors.As(err, &a) && !errors.As(err, &b) && !errors.As(err, &c)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				return testutil.NewStringResponse(tt.body, tt.statusCode)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+			_, err := client.Bot.PauseRecording(context.Background(), "bot_id")
+
+			if !tt.is(err) {
+				t.Errorf("PauseRecording() error = %T %v", errors.Unwrap(err), err)
+			}
+			var apiErr *recallaigo.Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.statusCode {
+				t.Errorf("PauseRecording() error = %v, want it to wrap an *Error", err)
+			}
+		})
+	}
+}
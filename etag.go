@@ -0,0 +1,69 @@
+package recallaigo
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WithETags makes the client send conditional GET requests. Responses carrying an ETag are kept
+// in the store, and the next request for the same URL sends their ETag in If-None-Match;
+// if the API answers 304 Not Modified, the kept response is returned as if it had been sent again.
+// This makes frequent polling, e.g. with WaitForStatus, cheaper for the API and the network.
+//
+// The store may be the one of WithCache. Responses without an ETag are not kept. DeleteBotMedia
+// and DeleteScheduledBot evict the responses of the bot.
+func WithETags(store CacheStore) ClientOption {
+	return func(c *Client) {
+		c.etags = store
+	}
+}
+
+// doConditional sends the request like doShared, revalidating the kept response of a GET request
+// if ETags are enabled.
+func (c *Client) doConditional(req *http.Request) (*http.Response, error) {
+	if c.etags == nil || req.Method != http.MethodGet {
+		return c.doShared(req)
+	}
+
+	key := c.cacheKey("etag", req.URL.String())
+	entry, cached := c.etags.Get(key)
+	var etag, body []byte
+	if cached {
+		etag, body, cached = bytes.Cut(entry, []byte("\n"))
+	}
+	if cached {
+		req.Header.Set("If-None-Match", string(etag))
+	}
+
+	res, err := c.doShared(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode == http.StatusNotModified && cached:
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		res.StatusCode = http.StatusOK
+		res.Status = "200 OK"
+		res.ContentLength = int64(len(body))
+		res.Body = io.NopCloser(bytes.NewReader(body))
+	case res.StatusCode == http.StatusOK && res.Header.Get("ETag") != "":
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		c.etags.Set(key, append([]byte(res.Header.Get("ETag")+"\n"), body...))
+		if botID := botIDFromPath(strings.TrimPrefix(req.URL.Path, c.baseUrl.Path)); botID != "" {
+			c.indexBotKey(c.etags, botID, key)
+		}
+		res.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return res, nil
+}
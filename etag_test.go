@@ -0,0 +1,77 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestETags(t *testing.T) {
+	type response struct {
+		status int
+		etag   string
+		body   string
+	}
+	tests := []struct {
+		name         string
+		responses    []response
+		wantIfNone   []string
+		wantStatuses []recallaigo.Status
+	}{
+		{
+			name: "returns the kept response when not modified",
+			responses: []response{
+				{status: http.StatusOK, etag: `"v1"`, body: `{"id": "some_id", "status_changes": [{"code": "joining_call"}]}`},
+				{status: http.StatusNotModified},
+				{status: http.StatusOK, etag: `"v2"`, body: `{"id": "some_id", "status_changes": [{"code": "done"}]}`},
+				{status: http.StatusNotModified},
+			},
+			wantIfNone:   []string{"", `"v1"`, `"v1"`, `"v2"`},
+			wantStatuses: []recallaigo.Status{recallaigo.StatusJoiningCall, recallaigo.StatusJoiningCall, recallaigo.StatusDone, recallaigo.StatusDone},
+		},
+		{
+			name: "sends unconditional requests without ETags",
+			responses: []response{
+				{status: http.StatusOK, body: `{"id": "some_id", "status_changes": [{"code": "joining_call"}]}`},
+				{status: http.StatusOK, body: `{"id": "some_id", "status_changes": [{"code": "done"}]}`},
+			},
+			wantIfNone:   []string{"", ""},
+			wantStatuses: []recallaigo.Status{recallaigo.StatusJoiningCall, recallaigo.StatusDone},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ifNoneMatch []string
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				ifNoneMatch = append(ifNoneMatch, req.Header.Get("If-None-Match"))
+				r := tt.responses[len(ifNoneMatch)-1]
+				res := testutil.NewStringResponse(r.body, r.status)
+				if r.etag != "" {
+					res.Header.Set("ETag", r.etag)
+				}
+				return res
+			})
+			client := recallaigo.NewClient("some_token",
+				recallaigo.WithHTTPClient(c),
+				recallaigo.WithETags(recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{})),
+			)
+
+			for i, want := range tt.wantStatuses {
+				bot, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+				if err != nil {
+					t.Fatalf("RetrieveBot() #%d error = %v", i, err)
+				}
+				if bot.CurrentStatus() != want {
+					t.Errorf("RetrieveBot() #%d status = %s, want %s", i, bot.CurrentStatus(), want)
+				}
+				if ifNoneMatch[i] != tt.wantIfNone[i] {
+					t.Errorf("request #%d If-None-Match = %q, want %q", i, ifNoneMatch[i], tt.wantIfNone[i])
+				}
+			}
+		})
+	}
+}
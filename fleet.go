@@ -0,0 +1,83 @@
+package recallaigo
+
+import (
+	"context"
+	"time"
+)
+
+// FleetStats summarizes a set of bots, e.g. for an on-call dashboard.
+type FleetStats struct {
+	Total      int
+	ByStatus   map[Status]int
+	ByPlatform map[Platform]int
+	// The variants of the bots for their platform. Bots using the default variant are not counted.
+	ByVariant map[VariantOption]int
+	// Bots scheduled to join a call later.
+	Scheduled int
+	// Bots that are neither scheduled nor in a terminal status, e.g. in a call.
+	Active int
+	// The active bot that has been in its current status the longest, nil if there is none.
+	OldestActive *Bot
+	// When OldestActive changed to its current status.
+	OldestActiveSince time.Time
+}
+
+// SummarizeFleet aggregates the bots, e.g. the results of ListBots.
+func SummarizeFleet(bots []Bot) *FleetStats {
+	stats := &FleetStats{
+		ByStatus:   make(map[Status]int),
+		ByPlatform: make(map[Platform]int),
+		ByVariant:  make(map[VariantOption]int),
+	}
+	for i := range bots {
+		stats.Add(&bots[i])
+	}
+	return stats
+}
+
+// CollectFleetStats aggregates the bots of every page of ListBots. params filter the bots,
+// e.g. by JoinAtAfter; Page is ignored.
+func CollectFleetStats(ctx context.Context, bots BotService, params ...ListBotsParams) (*FleetStats, error) {
+	var p ListBotsParams
+	if len(params) > 0 {
+		p = params[0]
+	}
+
+	stats := SummarizeFleet(nil)
+	err := forEachBot(ctx, bots, p, func(_ int, bot *Bot) error {
+		stats.Add(bot)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// Add counts the bot in the statistics. The bot is kept if it becomes OldestActive,
+// so it must not be modified afterwards.
+func (s *FleetStats) Add(bot *Bot) {
+	status := bot.CurrentStatus()
+	platform := Platform(bot.MeetingURL.Platform)
+
+	s.Total++
+	s.ByStatus[status]++
+	s.ByPlatform[platform]++
+	if bot.Variant != nil {
+		if variant := bot.Variant.forPlatform(platform); variant != "" {
+			s.ByVariant[variant]++
+		}
+	}
+
+	switch {
+	case bot.isScheduled():
+		s.Scheduled++
+	case !status.IsTerminal():
+		s.Active++
+		if change := bot.LatestStatusChange(); change != nil && !change.CreatedAt.IsZero() &&
+			(s.OldestActive == nil || change.CreatedAt.Before(s.OldestActiveSince)) {
+			s.OldestActive = bot
+			s.OldestActiveSince = change.CreatedAt.Time
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package recallaigo_test
+
+import (
+	"encoding/json"
+	"maps"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestSummarizeFleet(t *testing.T) {
+	var bots []recallaigo.Bot
+	err := json.Unmarshal([]byte(`[
+		{"id": "scheduled", "join_at": "2025-03-20T10:00:00Z", "meeting_url": "https://zoom.us/j/1", "status_changes": []},
+		{"id": "recording", "meeting_url": "https://zoom.us/j/2", "variants": {"zoom": "web_4_core"},
+			"status_changes": [{"code": "joining_call", "created_at": "2025-03-18T10:00:00Z"}, {"code": "in_call_recording", "created_at": "2025-03-18T10:01:00Z"}]},
+		{"id": "waiting", "meeting_url": "https://meet.google.com/abc-defg-hij", "variants": {"zoom": "native"},
+			"status_changes": [{"code": "in_waiting_room", "created_at": "2025-03-18T09:30:00Z"}]},
+		{"id": "done", "meeting_url": "https://teams.microsoft.com/l/meetup-join/abc", "variants": {"microsoft_teams": "web"},
+			"status_changes": [{"code": "done", "created_at": "2025-03-18T08:00:00Z"}]}
+	]`), &bots)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := recallaigo.SummarizeFleet(bots)
+
+	if stats.Total != 4 || stats.Scheduled != 1 || stats.Active != 2 {
+		t.Errorf("SummarizeFleet() total, scheduled, active = %d, %d, %d, want 4, 1, 2", stats.Total, stats.Scheduled, stats.Active)
+	}
+	wantStatus := map[recallaigo.Status]int{
+		"":                               1,
+		recallaigo.StatusInCallRecording: 1,
+		recallaigo.StatusInWaitingRoom:   1,
+		recallaigo.StatusDone:            1,
+	}
+	if !maps.Equal(stats.ByStatus, wantStatus) {
+		t.Errorf("SummarizeFleet() by status = %v, want %v", stats.ByStatus, wantStatus)
+	}
+	wantPlatform := map[recallaigo.Platform]int{
+		recallaigo.PlatformZoom:           2,
+		recallaigo.PlatformGoogleMeet:     1,
+		recallaigo.PlatformMicrosoftTeams: 1,
+	}
+	if !maps.Equal(stats.ByPlatform, wantPlatform) {
+		t.Errorf("SummarizeFleet() by platform = %v, want %v", stats.ByPlatform, wantPlatform)
+	}
+	// The Zoom variant of the Google Meet bot does not apply to it.
+	wantVariant := map[recallaigo.VariantOption]int{
+		recallaigo.VariantWeb4Core: 1,
+		recallaigo.VariantWeb:      1,
+	}
+	if !maps.Equal(stats.ByVariant, wantVariant) {
+		t.Errorf("SummarizeFleet() by variant = %v, want %v", stats.ByVariant, wantVariant)
+	}
+
+	if stats.OldestActive == nil || stats.OldestActive.ID != "waiting" {
+		t.Fatalf("SummarizeFleet() oldest active = %+v, want waiting", stats.OldestActive)
+	}
+	if want := time.Date(2025, 3, 18, 9, 30, 0, 0, time.UTC); !stats.OldestActiveSince.Equal(want) {
+		t.Errorf("SummarizeFleet() oldest active since = %v, want %v", stats.OldestActiveSince, want)
+	}
+}
@@ -0,0 +1,280 @@
+// Package gateway exposes the bot operations of recallaigo over a small authenticated REST API,
+// so that services not written in Go can create bots and fetch transcripts through one audited
+// place holding the Recall.ai API key.
+//
+//	client := recallaigo.NewClient(apiKey)
+//	gw := gateway.New(client.Bot, gateway.Options{
+//		Auth:  gateway.BearerTokens{"token-of-billing": "billing"},
+//		Audit: func(e gateway.AuditEvent) { log.Printf("%+v", e) },
+//	})
+//	http.ListenAndServe(":8080", gw)
+//
+// Routes:
+//
+//	POST   /bots                 creates a bot from a recallaigo.CreateBotRequest
+//	GET    /bots                 lists bots, filtered by the status and platform query parameters
+//	GET    /bots/{id}            retrieves a bot
+//	DELETE /bots/{id}            deletes a scheduled bot
+//	POST   /bots/{id}/leave      removes a bot from its call
+//	GET    /bots/{id}/transcript retrieves the transcript of a bot
+//
+// Errors are answered as {"error": "..."}, with the status of the API for its client errors,
+// e.g. 404 for unknown bots, and 502 Bad Gateway for its other failures, including the rejection
+// of the Recall.ai API key. Rate limiting of the API is answered with 503 Service Unavailable.
+// The response of the API itself is only recorded in the audit event. Request bodies are limited
+// to 1 MiB.
+package gateway
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// ErrUnauthenticated is returned by an Authenticator for requests without valid credentials.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Authenticator identifies the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the name of the caller, e.g. a service name, or an error
+	// if the request has no valid credentials.
+	Authenticate(r *http.Request) (principal string, err error)
+}
+
+// BearerTokens authenticates requests with an "Authorization: Bearer <token>" header.
+// It maps each accepted token to the name of its caller.
+type BearerTokens map[string]string
+
+func (t BearerTokens) Authenticate(r *http.Request) (string, error) {
+	const prefix = "Bearer "
+	header := r.Header.Get("Authorization")
+	if len(header) <= len(prefix) || header[:len(prefix)] != prefix {
+		return "", ErrUnauthenticated
+	}
+
+	// Compare every token in constant time, so that the timing reveals nothing about them.
+	given := []byte(header[len(prefix):])
+	principal := ""
+	for token, name := range t {
+		if subtle.ConstantTimeCompare(given, []byte(token)) == 1 {
+			principal = name
+		}
+	}
+	if principal == "" {
+		return "", ErrUnauthenticated
+	}
+	return principal, nil
+}
+
+// AuditEvent records a request handled by the gateway.
+type AuditEvent struct {
+	Time      time.Time
+	Principal string
+	// The operation, e.g. "CreateBot". Empty for requests matching no route.
+	Operation string
+	// The bot of the operation, if any. For CreateBot, the ID of the created bot.
+	BotID    string
+	Status   int
+	Duration time.Duration
+	// The error answered, if any.
+	Err string
+}
+
+// Options configures a Gateway.
+type Options struct {
+	// Authenticates the callers. Required.
+	Auth Authenticator
+	// Called with every request, including rejected ones.
+	Audit func(AuditEvent)
+	// The clock of the audit events. Defaults to recallaigo.SystemClock.
+	Clock recallaigo.Clock
+}
+
+// Gateway is an http.Handler serving the bot operations of recallaigo.
+type Gateway struct {
+	bots recallaigo.BotService
+	opts Options
+	mux  *http.ServeMux
+}
+
+// New creates a Gateway performing its operations with bots, e.g. the Bot service of a client.
+// It panics if opts.Auth is nil.
+func New(bots recallaigo.BotService, opts Options) *Gateway {
+	if opts.Auth == nil {
+		panic("gateway: no Authenticator")
+	}
+	if opts.Audit == nil {
+		opts.Audit = func(AuditEvent) {}
+	}
+	if opts.Clock == nil {
+		opts.Clock = recallaigo.SystemClock{}
+	}
+
+	g := &Gateway{bots: bots, opts: opts, mux: http.NewServeMux()}
+	g.handle("POST /bots", "CreateBot", g.createBot)
+	g.handle("GET /bots", "ListBots", g.listBots)
+	g.handle("GET /bots/{id}", "RetrieveBot", g.retrieveBot)
+	g.handle("DELETE /bots/{id}", "DeleteScheduledBot", g.deleteScheduledBot)
+	g.handle("POST /bots/{id}/leave", "RemoveBotFromCall", g.removeBotFromCall)
+	g.handle("GET /bots/{id}/transcript", "GetBotTranscript", g.getBotTranscript)
+	return g
+}
+
+// maxRequestBodySize is the maximum size of request bodies, far above the size of any bot request.
+const maxRequestBodySize = 1 << 20
+
+// operation handles a request, returning the response body, or nil for 204 No Content.
+type operation func(ctx context.Context, r *http.Request, event *AuditEvent) (any, error)
+
+// requestError is an error caused by the request rather than the API.
+type requestError struct {
+	status int
+	err    error
+}
+
+func (e *requestError) Error() string {
+	return e.err.Error()
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := g.opts.Clock.Now()
+	event := AuditEvent{Time: start}
+
+	principal, err := g.opts.Auth.Authenticate(r)
+	if err != nil {
+		event.Status, event.Err = http.StatusUnauthorized, err.Error()
+		writeJSON(w, event.Status, map[string]string{"error": "unauthenticated"})
+		g.opts.Audit(event)
+		return
+	}
+	event.Principal = principal
+
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	g.mux.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), auditKey{}, &event)))
+
+	event.Status = rec.status
+	event.Duration = g.opts.Clock.Now().Sub(start)
+	g.opts.Audit(event)
+}
+
+// auditKey is the context key of the audit event of a request.
+type auditKey struct{}
+
+func (g *Gateway) handle(pattern, name string, op operation) {
+	g.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		event := r.Context().Value(auditKey{}).(*AuditEvent)
+		event.Operation = name
+		event.BotID = r.PathValue("id")
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+		body, err := op(r.Context(), r, event)
+		if err != nil {
+			event.Err = err.Error()
+			status, message := errorResponse(err)
+			writeJSON(w, status, map[string]string{"error": message})
+			return
+		}
+		if body == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// errorResponse returns the status and the message answered for an error. Messages never
+// include the response of the API, which may reveal details of the account of the gateway.
+func errorResponse(err error) (int, string) {
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		return reqErr.status, reqErr.Error()
+	}
+
+	var apiErr *recallaigo.Error
+	if !errors.As(err, &apiErr) {
+		return http.StatusBadGateway, "upstream request failed"
+	}
+	switch {
+	case apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden:
+		// The API rejected the key of the gateway, not the credentials of the caller.
+		return http.StatusBadGateway, "upstream request failed"
+	case apiErr.StatusCode == http.StatusTooManyRequests:
+		return http.StatusServiceUnavailable, "upstream rate limit exceeded"
+	case apiErr.StatusCode >= 400 && apiErr.StatusCode < 500:
+		// Client errors of the API, such as an unknown bot, are the caller's.
+		return apiErr.StatusCode, strings.ToLower(http.StatusText(apiErr.StatusCode))
+	}
+	return http.StatusBadGateway, "upstream request failed"
+}
+
+func (g *Gateway) createBot(ctx context.Context, r *http.Request, event *AuditEvent) (any, error) {
+	var request recallaigo.CreateBotRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, &requestError{http.StatusRequestEntityTooLarge, errors.New("bot request too large")}
+		}
+		return nil, &requestError{http.StatusBadRequest, errors.New("invalid bot request")}
+	}
+
+	bot, err := g.bots.CreateBot(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+	event.BotID = bot.ID
+	return bot, nil
+}
+
+func (g *Gateway) listBots(ctx context.Context, r *http.Request, _ *AuditEvent) (any, error) {
+	params := &recallaigo.ListBotsParams{
+		JoinAtAfter:  r.URL.Query().Get("join_at_after"),
+		JoinAtBefore: r.URL.Query().Get("join_at_before"),
+		MeetingURL:   r.URL.Query().Get("meeting_url"),
+	}
+	for _, status := range r.URL.Query()["status"] {
+		params.Status = append(params.Status, recallaigo.Status(status))
+	}
+	for _, platform := range r.URL.Query()["platform"] {
+		params.Platform = append(params.Platform, recallaigo.Platform(platform))
+	}
+	return g.bots.ListBots(ctx, params)
+}
+
+func (g *Gateway) retrieveBot(ctx context.Context, r *http.Request, _ *AuditEvent) (any, error) {
+	return g.bots.RetrieveBot(ctx, r.PathValue("id"))
+}
+
+func (g *Gateway) deleteScheduledBot(ctx context.Context, r *http.Request, _ *AuditEvent) (any, error) {
+	return nil, g.bots.DeleteScheduledBot(ctx, r.PathValue("id"))
+}
+
+func (g *Gateway) removeBotFromCall(ctx context.Context, r *http.Request, _ *AuditEvent) (any, error) {
+	return g.bots.RemoveBotFromCall(ctx, r.PathValue("id"))
+}
+
+func (g *Gateway) getBotTranscript(ctx context.Context, r *http.Request, _ *AuditEvent) (any, error) {
+	return g.bots.GetBotTranscript(ctx, r.PathValue("id"))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// statusRecorder keeps the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
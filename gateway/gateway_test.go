@@ -0,0 +1,279 @@
+package gateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harrison-peng/recallai-go/gateway"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+const botID = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+
+func TestGateway(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+	srv.SetResponse(recalltest.RouteDeleteScheduledBot, recalltest.ErrorResponse(http.StatusNotFound))
+
+	clock := recalltest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	var events []gateway.AuditEvent
+	gw := gateway.New(srv.NewClient().Bot, gateway.Options{
+		Auth:  gateway.BearerTokens{"secret": "billing"},
+		Audit: func(e gateway.AuditEvent) { events = append(events, e) },
+		Clock: clock,
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		body       string
+		wantStatus int
+		wantEvent  gateway.AuditEvent
+		wantRoute  string
+	}{
+		{
+			name:       "create bot",
+			method:     http.MethodPost,
+			path:       "/bots",
+			token:      "secret",
+			body:       `{"meeting_url": "https://meet.google.com/abc-defg-hij", "bot_name": "Notetaker"}`,
+			wantStatus: http.StatusOK,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "CreateBot", BotID: botID, Status: http.StatusOK},
+			wantRoute:  recalltest.RouteCreateBot,
+		},
+		{
+			name:       "invalid bot request",
+			method:     http.MethodPost,
+			path:       "/bots",
+			token:      "secret",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "CreateBot", Status: http.StatusBadRequest, Err: "invalid bot request"},
+		},
+		{
+			name:       "list bots",
+			method:     http.MethodGet,
+			path:       "/bots?status=done",
+			token:      "secret",
+			wantStatus: http.StatusOK,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "ListBots", Status: http.StatusOK},
+			wantRoute:  recalltest.RouteListBots,
+		},
+		{
+			name:       "retrieve bot",
+			method:     http.MethodGet,
+			path:       "/bots/" + botID,
+			token:      "secret",
+			wantStatus: http.StatusOK,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "RetrieveBot", BotID: botID, Status: http.StatusOK},
+			wantRoute:  recalltest.RouteRetrieveBot,
+		},
+		{
+			name:       "leave call",
+			method:     http.MethodPost,
+			path:       "/bots/" + botID + "/leave",
+			token:      "secret",
+			wantStatus: http.StatusOK,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "RemoveBotFromCall", BotID: botID, Status: http.StatusOK},
+			wantRoute:  recalltest.RouteRemoveBotFromCall,
+		},
+		{
+			name:       "get transcript",
+			method:     http.MethodGet,
+			path:       "/bots/" + botID + "/transcript",
+			token:      "secret",
+			wantStatus: http.StatusOK,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "GetBotTranscript", BotID: botID, Status: http.StatusOK},
+			wantRoute:  recalltest.RouteGetBotTranscript,
+		},
+		{
+			name:       "unknown bot",
+			method:     http.MethodDelete,
+			path:       "/bots/" + botID,
+			token:      "secret",
+			wantStatus: http.StatusNotFound,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Operation: "DeleteScheduledBot", BotID: botID, Status: http.StatusNotFound},
+			wantRoute:  recalltest.RouteDeleteScheduledBot,
+		},
+		{
+			name:       "unknown route",
+			method:     http.MethodGet,
+			path:       "/media",
+			token:      "secret",
+			wantStatus: http.StatusNotFound,
+			wantEvent:  gateway.AuditEvent{Principal: "billing", Status: http.StatusNotFound},
+		},
+		{
+			name:       "wrong token",
+			method:     http.MethodGet,
+			path:       "/bots",
+			token:      "guess",
+			wantStatus: http.StatusUnauthorized,
+			wantEvent:  gateway.AuditEvent{Status: http.StatusUnauthorized, Err: "unauthenticated"},
+		},
+		{
+			name:       "no token",
+			method:     http.MethodGet,
+			path:       "/bots",
+			wantStatus: http.StatusUnauthorized,
+			wantEvent:  gateway.AuditEvent{Status: http.StatusUnauthorized, Err: "unauthenticated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events = nil
+			requests := len(srv.Requests())
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			gw.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code != http.StatusNotFound && !json.Valid(rec.Body.Bytes()) {
+				t.Errorf("body is not JSON: %s", rec.Body)
+			}
+
+			if len(events) != 1 {
+				t.Fatalf("got %d audit events, want 1", len(events))
+			}
+			got := events[0]
+			if tt.wantRoute != "" && got.Status >= 400 {
+				// The error of the API is not part of the expectation.
+				if got.Err == "" {
+					t.Errorf("Err is empty")
+				}
+				got.Err = ""
+			}
+			tt.wantEvent.Time = clock.Now()
+			if got != tt.wantEvent {
+				t.Errorf("audit event = %+v, want %+v", got, tt.wantEvent)
+			}
+
+			sent := srv.Requests()[requests:]
+			if tt.wantRoute == "" {
+				if len(sent) != 0 {
+					t.Errorf("sent %d requests to the API, want none", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 || sent[0].Route != tt.wantRoute {
+				t.Errorf("sent %+v, want one request to %s", sent, tt.wantRoute)
+			}
+		})
+	}
+}
+
+func TestGatewayErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		upstream    int
+		body        string
+		wantStatus  int
+		wantMessage string
+	}{
+		{
+			name:        "unknown bot",
+			upstream:    http.StatusNotFound,
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "not found",
+		},
+		{
+			name:        "rejected API key",
+			upstream:    http.StatusUnauthorized,
+			wantStatus:  http.StatusBadGateway,
+			wantMessage: "upstream request failed",
+		},
+		{
+			name:        "forbidden API key",
+			upstream:    http.StatusForbidden,
+			wantStatus:  http.StatusBadGateway,
+			wantMessage: "upstream request failed",
+		},
+		{
+			name:        "rate limited",
+			upstream:    http.StatusTooManyRequests,
+			wantStatus:  http.StatusServiceUnavailable,
+			wantMessage: "upstream rate limit exceeded",
+		},
+		{
+			name:        "server error",
+			upstream:    http.StatusInternalServerError,
+			wantStatus:  http.StatusBadGateway,
+			wantMessage: "upstream request failed",
+		},
+		{
+			name:        "body too large",
+			body:        `{"meeting_url": "` + strings.Repeat("a", 2<<20) + `"}`,
+			wantStatus:  http.StatusRequestEntityTooLarge,
+			wantMessage: "bot request too large",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := recalltest.NewServer()
+			defer srv.Close()
+			if tt.upstream != 0 {
+				srv.SetResponse(recalltest.RouteCreateBot, recalltest.ErrorResponse(tt.upstream))
+			}
+			gw := gateway.New(srv.NewClient().Bot, gateway.Options{Auth: gateway.BearerTokens{"secret": "billing"}})
+
+			body := tt.body
+			if body == "" {
+				body = `{"meeting_url": "https://meet.google.com/abc-defg-hij", "bot_name": "Notetaker"}`
+			}
+			req := httptest.NewRequest(http.MethodPost, "/bots", strings.NewReader(body))
+			req.Header.Set("Authorization", "Bearer secret")
+			rec := httptest.NewRecorder()
+			gw.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			var got struct {
+				Error string `json:"error"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || got.Error != tt.wantMessage {
+				t.Errorf("body = %s, want the error %q", rec.Body, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestBearerTokens(t *testing.T) {
+	auth := gateway.BearerTokens{"a": "alpha", "b": "beta"}
+
+	tests := []struct {
+		header  string
+		want    string
+		wantErr bool
+	}{
+		{header: "Bearer a", want: "alpha"},
+		{header: "Bearer b", want: "beta"},
+		{header: "Bearer c", wantErr: true},
+		{header: "Bearer ", wantErr: true},
+		{header: "Basic a", wantErr: true},
+		{header: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/bots", nil)
+		req.Header.Set("Authorization", tt.header)
+		got, err := auth.Authenticate(req)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Authenticate(%q) = %q, %v, want %q, error %v", tt.header, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package recallaigo
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Keys of the analysis results in the intelligence response.
+const (
+	IntelligenceKeyAssemblyAISummary             = "assembly_ai.summary"
+	IntelligenceKeyAssemblyAIChapters            = "assembly_ai.chapters"
+	IntelligenceKeyAssemblyAIHighlights          = "assembly_ai.auto_highlights_result"
+	IntelligenceKeyAssemblyAIContentSafetyLabels = "assembly_ai.content_safety_labels"
+	IntelligenceKeyDeepgramSummaries             = "deepgram.summaries"
+	IntelligenceKeyDeepgramTopics                = "deepgram.topics"
+)
+
+// IntelligenceResult holds the results of the analysis of a bot's media.
+// Known results are decoded into the provider fields. Every result, including ones without
+// a typed field or that fail to decode into one, is kept in Raw by its key, e.g. "assembly_ai.chapters".
+type IntelligenceResult struct {
+	AssemblyAI *AssemblyAIIntelligence
+	Deepgram   *DeepgramIntelligence
+	Gladia     *GladiaIntelligence
+
+	Raw map[string]json.RawMessage
+}
+
+// AssemblyAIIntelligence holds the results of the AssemblyAI audio intelligence models.
+// see https://www.assemblyai.com/docs/audio-intelligence
+type AssemblyAIIntelligence struct {
+	Summary       string
+	Chapters      []AssemblyAIChapter
+	Highlights    *AssemblyAIHighlights
+	ContentSafety *AssemblyAIContentSafety
+}
+
+// AssemblyAIChapter is a chapter detected by AssemblyAI auto chapters.
+type AssemblyAIChapter struct {
+	Gist     string `json:"gist"`
+	Headline string `json:"headline"`
+	Summary  string `json:"summary"`
+	// The start time in milliseconds.
+	Start int `json:"start"`
+	// The end time in milliseconds.
+	End int `json:"end"`
+}
+
+// AssemblyAIHighlights is the result of AssemblyAI auto highlights.
+type AssemblyAIHighlights struct {
+	Status  string                `json:"status"`
+	Results []AssemblyAIHighlight `json:"results"`
+}
+
+// AssemblyAIHighlight is a key phrase of the recording.
+type AssemblyAIHighlight struct {
+	Text  string  `json:"text"`
+	Count int     `json:"count"`
+	Rank  float64 `json:"rank"`
+	// The times the phrase is spoken.
+	Timestamps []AssemblyAITimestamp `json:"timestamps"`
+}
+
+// AssemblyAITimestamp is a time range in milliseconds.
+type AssemblyAITimestamp struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// AssemblyAIContentSafety is the result of AssemblyAI content moderation.
+type AssemblyAIContentSafety struct {
+	Status  string                          `json:"status"`
+	Results []AssemblyAIContentSafetyResult `json:"results"`
+	// The confidence of each label over the whole recording.
+	Summary map[string]float64 `json:"summary"`
+}
+
+// AssemblyAIContentSafetyResult is a section of the recording with sensitive content.
+type AssemblyAIContentSafetyResult struct {
+	Text      string                         `json:"text"`
+	Labels    []AssemblyAIContentSafetyLabel `json:"labels"`
+	Timestamp AssemblyAITimestamp            `json:"timestamp"`
+}
+
+// AssemblyAIContentSafetyLabel is a kind of sensitive content, e.g. "profanity".
+type AssemblyAIContentSafetyLabel struct {
+	Label      string  `json:"label"`
+	Confidence float64 `json:"confidence"`
+	// The severity between 0 and 1.
+	Severity float64 `json:"severity"`
+}
+
+// DeepgramIntelligence holds the results of the Deepgram audio intelligence features.
+// see https://developers.deepgram.com/docs/audio-intelligence
+type DeepgramIntelligence struct {
+	Summaries []DeepgramSummary
+	Topics    []DeepgramTopicSegment
+}
+
+// DeepgramSummary summarizes a range of words of the transcript.
+type DeepgramSummary struct {
+	Summary   string `json:"summary"`
+	StartWord int    `json:"start_word"`
+	EndWord   int    `json:"end_word"`
+}
+
+// DeepgramTopicSegment is a range of words of the transcript with its topics.
+type DeepgramTopicSegment struct {
+	Text      string          `json:"text"`
+	StartWord int             `json:"start_word"`
+	EndWord   int             `json:"end_word"`
+	Topics    []DeepgramTopic `json:"topics"`
+}
+
+// DeepgramTopic is a topic detected by Deepgram.
+type DeepgramTopic struct {
+	Topic      string  `json:"topic"`
+	Confidence float64 `json:"confidence_score"`
+}
+
+// Chapter is a section of the meeting with its times in seconds, see AssemblyAIChapters.
+type Chapter struct {
+	Title   string
+	Summary string
+	// The start time in seconds since the start of the recording.
+	Start float64
+	// The end time in seconds since the start of the recording.
+	End float64
+}
+
+// Summary returns a summary of the meeting from whichever provider produced one,
+// or an empty string if there is none.
+func (r *IntelligenceResult) Summary() string {
+	if r.AssemblyAI != nil && r.AssemblyAI.Summary != "" {
+		return r.AssemblyAI.Summary
+	}
+	if r.Deepgram != nil && len(r.Deepgram.Summaries) > 0 {
+		parts := make([]string, 0, len(r.Deepgram.Summaries))
+		for _, summary := range r.Deepgram.Summaries {
+			if summary.Summary != "" {
+				parts = append(parts, summary.Summary)
+			}
+		}
+		return strings.Join(parts, " ")
+	}
+
+	// Fall back to the summaries of the chapters.
+	var parts []string
+	for _, chapter := range r.AssemblyAIChapters() {
+		if chapter.Summary != "" {
+			parts = append(parts, chapter.Summary)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// AssemblyAIChapters returns the chapters detected by AssemblyAI auto chapters, with their times
+// in seconds. It is AssemblyAI only, as no other provider detects chapters with times; the topic
+// segments of Deepgram span words of the transcript instead, see DeepgramTopicSegment.
+func (r *IntelligenceResult) AssemblyAIChapters() []Chapter {
+	if r.AssemblyAI == nil {
+		return nil
+	}
+
+	chapters := make([]Chapter, 0, len(r.AssemblyAI.Chapters))
+	for _, chapter := range r.AssemblyAI.Chapters {
+		chapters = append(chapters, Chapter{
+			Title:   chapter.Headline,
+			Summary: chapter.Summary,
+			Start:   float64(chapter.Start) / 1000,
+			End:     float64(chapter.End) / 1000,
+		})
+	}
+	return chapters
+}
+
+func (r *IntelligenceResult) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*r = IntelligenceResult{Raw: raw}
+
+	for key, value := range raw {
+		provider, _, _ := strings.Cut(key, ".")
+		switch provider {
+		case "assembly_ai":
+			if r.AssemblyAI == nil {
+				r.AssemblyAI = &AssemblyAIIntelligence{}
+			}
+		case "deepgram":
+			if r.Deepgram == nil {
+				r.Deepgram = &DeepgramIntelligence{}
+			}
+		case "gladia":
+			if r.Gladia == nil {
+				r.Gladia = &GladiaIntelligence{}
+			}
+		}
+
+		// Results that do not match their typed field are left in Raw only.
+		switch key {
+		case IntelligenceKeyAssemblyAISummary:
+			decodeIntelligence(value, &r.AssemblyAI.Summary)
+		case IntelligenceKeyAssemblyAIChapters:
+			decodeIntelligence(value, &r.AssemblyAI.Chapters)
+		case IntelligenceKeyAssemblyAIHighlights:
+			decodeIntelligence(value, &r.AssemblyAI.Highlights)
+		case IntelligenceKeyAssemblyAIContentSafetyLabels:
+			decodeIntelligence(value, &r.AssemblyAI.ContentSafety)
+		case IntelligenceKeyDeepgramSummaries:
+			decodeIntelligence(value, &r.Deepgram.Summaries)
+		case IntelligenceKeyDeepgramTopics:
+			var topics struct {
+				Segments []DeepgramTopicSegment `json:"segments"`
+			}
+			if decodeIntelligence(value, &topics) {
+				r.Deepgram.Topics = topics.Segments
+			}
+		case IntelligenceKeyGladiaModeration:
+			decodeIntelligence(value, &r.Gladia.Moderation)
+		}
+	}
+
+	return nil
+}
+
+// decodeIntelligence decodes a result into v, leaving v unchanged if it does not match.
+func decodeIntelligence[T any](data json.RawMessage, v *T) bool {
+	var decoded T
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return false
+	}
+	*v = decoded
+	return true
+}
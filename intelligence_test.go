@@ -0,0 +1,134 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestGetBotIntelligence(t *testing.T) {
+	var path string
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		path = req.URL.Path
+		return testutil.NewFileResponse(t, "test_data/get_bot_intelligence.json", http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	result, err := client.Bot.GetBotIntelligence(context.Background(), "some_id")
+	if err != nil {
+		t.Fatalf("GetBotIntelligence() error = %v", err)
+	}
+	if path != "/api/v1/bot/some_id/intelligence" {
+		t.Errorf("GetBotIntelligence() path = %q, want %q", path, "/api/v1/bot/some_id/intelligence")
+	}
+
+	assemblyAI := result.AssemblyAI
+	if assemblyAI == nil {
+		t.Fatal("AssemblyAI = nil, want results")
+	}
+	if assemblyAI.Summary != "The team planned the release and agreed on a date." {
+		t.Errorf("AssemblyAI.Summary = %q", assemblyAI.Summary)
+	}
+	if len(assemblyAI.Chapters) != 1 || assemblyAI.Chapters[0].End != 90000 {
+		t.Errorf("AssemblyAI.Chapters = %+v, want one chapter ending at 90000", assemblyAI.Chapters)
+	}
+	if assemblyAI.Highlights == nil || len(assemblyAI.Highlights.Results[0].Timestamps) != 2 {
+		t.Errorf("AssemblyAI.Highlights = %+v, want a highlight spoken twice", assemblyAI.Highlights)
+	}
+	if safety := assemblyAI.ContentSafety; safety == nil || safety.Results[0].Labels[0].Label != "profanity" {
+		t.Errorf("AssemblyAI.ContentSafety = %+v, want profanity", safety)
+	}
+
+	deepgram := result.Deepgram
+	if deepgram == nil {
+		t.Fatal("Deepgram = nil, want results")
+	}
+	if len(deepgram.Summaries) != 1 || deepgram.Summaries[0].EndWord != 120 {
+		t.Errorf("Deepgram.Summaries = %+v, want one summary", deepgram.Summaries)
+	}
+	if deepgram.Topics != nil {
+		t.Errorf("Deepgram.Topics = %+v, want nil for a malformed result", deepgram.Topics)
+	}
+
+	for _, key := range []string{"assembly_ai.iab_categories_result", recallaigo.IntelligenceKeyDeepgramTopics} {
+		if _, ok := result.Raw[key]; !ok {
+			t.Errorf("Raw[%q] is missing", key)
+		}
+	}
+}
+
+func TestIntelligenceResultSummary(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       recallaigo.IntelligenceResult
+		wantSummary  string
+		wantChapters []recallaigo.Chapter
+	}{
+		{
+			name: "prefers the AssemblyAI summary",
+			result: recallaigo.IntelligenceResult{
+				AssemblyAI: &recallaigo.AssemblyAIIntelligence{
+					Summary: "Release planning.",
+					Chapters: []recallaigo.AssemblyAIChapter{
+						{Headline: "Scope", Summary: "The scope was agreed.", Start: 0, End: 30500},
+					},
+				},
+				Deepgram: &recallaigo.DeepgramIntelligence{
+					Summaries: []recallaigo.DeepgramSummary{{Summary: "Ignored."}},
+				},
+			},
+			wantSummary: "Release planning.",
+			wantChapters: []recallaigo.Chapter{
+				{Title: "Scope", Summary: "The scope was agreed.", Start: 0, End: 30.5},
+			},
+		},
+		{
+			name: "joins Deepgram summaries",
+			result: recallaigo.IntelligenceResult{
+				Deepgram: &recallaigo.DeepgramIntelligence{
+					Summaries: []recallaigo.DeepgramSummary{{Summary: "First part."}, {Summary: "Second part."}},
+				},
+			},
+			wantSummary: "First part. Second part.",
+		},
+		{
+			name: "falls back to chapter summaries",
+			result: recallaigo.IntelligenceResult{
+				AssemblyAI: &recallaigo.AssemblyAIIntelligence{
+					Chapters: []recallaigo.AssemblyAIChapter{
+						{Headline: "Scope", Summary: "The scope was agreed.", End: 1000},
+						{Headline: "Date", Summary: "The date was set.", Start: 1000, End: 2000},
+					},
+				},
+			},
+			wantSummary: "The scope was agreed. The date was set.",
+			wantChapters: []recallaigo.Chapter{
+				{Title: "Scope", Summary: "The scope was agreed.", End: 1},
+				{Title: "Date", Summary: "The date was set.", Start: 1, End: 2},
+			},
+		},
+		{
+			name: "returns nothing without results",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.result.Summary(); got != tt.wantSummary {
+				t.Errorf("Summary() = %q, want %q", got, tt.wantSummary)
+			}
+			got := tt.result.AssemblyAIChapters()
+			if len(got) != len(tt.wantChapters) {
+				t.Fatalf("AssemblyAIChapters() = %+v, want %+v", got, tt.wantChapters)
+			}
+			for i := range got {
+				if got[i] != tt.wantChapters[i] {
+					t.Errorf("AssemblyAIChapters()[%d] = %+v, want %+v", i, got[i], tt.wantChapters[i])
+				}
+			}
+		})
+	}
+}
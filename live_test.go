@@ -0,0 +1,186 @@
+package recallaigo_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+// The live contract tests run against a real Recall.ai account when RECALLAI_LIVE_TESTS is set.
+// They decode strictly, so any field the API returns but this package does not know fails the test.
+//
+//	RECALLAI_LIVE_TESTS=1            enables the tests
+//	RECALLAI_API_KEY                 the API key of the account, preferably a sandbox one
+//	RECALLAI_REGION                  the region of the account, defaults to us-east-1
+//	RECALLAI_LIVE_BOT_ID             a finished bot whose media and transcript are read
+//	RECALLAI_LIVE_MEETING_URL        a meeting URL to schedule, update and delete a bot for
+//	RECALLAI_UPDATE_FIXTURES=1       rewrites the fixtures in test_data from RECALLAI_LIVE_BOT_ID
+func newLiveClient(t *testing.T) *recallaigo.Client {
+	t.Helper()
+
+	if os.Getenv("RECALLAI_LIVE_TESTS") == "" {
+		t.Skip("set RECALLAI_LIVE_TESTS to run the live contract tests")
+	}
+	token := os.Getenv("RECALLAI_API_KEY")
+	if token == "" {
+		t.Fatal("RECALLAI_LIVE_TESTS is set but RECALLAI_API_KEY is empty")
+	}
+
+	opts := []recallaigo.ClientOption{recallaigo.WithStrictDecoding(true)}
+	if region := os.Getenv("RECALLAI_REGION"); region != "" {
+		opts = append(opts, recallaigo.WithRegion(recallaigo.Region(region)))
+	}
+	return recallaigo.NewClient(token, opts...)
+}
+
+// liveEnv returns the value of an environment variable, skipping the test if it is not set.
+func liveEnv(t *testing.T, key string) string {
+	t.Helper()
+
+	value := os.Getenv(key)
+	if value == "" {
+		t.Skipf("set %s to run this live contract test", key)
+	}
+	return value
+}
+
+func TestLiveBotEndpoints(t *testing.T) {
+	client := newLiveClient(t)
+	botID := liveEnv(t, "RECALLAI_LIVE_BOT_ID")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "ListBots",
+			call: func() error {
+				_, err := client.Bot.ListBots(ctx, nil)
+				return err
+			},
+		},
+		{
+			name: "RetrieveBot",
+			call: func() error {
+				_, err := client.Bot.RetrieveBot(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "GetBotTranscript",
+			call: func() error {
+				_, err := client.Bot.GetBotTranscript(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "GetSpeakerTimeline",
+			call: func() error {
+				_, err := client.Bot.GetSpeakerTimeline(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "ListChatMessages",
+			call: func() error {
+				_, err := client.Bot.ListChatMessages(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "ListBotScreenshots",
+			call: func() error {
+				_, err := client.Bot.ListBotScreenshots(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "GetBotLogs",
+			call: func() error {
+				_, err := client.Bot.GetBotLogs(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "GetBotIntelligence",
+			call: func() error {
+				_, err := client.Bot.GetBotIntelligence(ctx, botID)
+				return err
+			},
+		},
+		{
+			name: "ListAudioMixed",
+			call: func() error {
+				_, err := client.Media.ListAudioMixed(ctx, nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Errorf("%s() error = %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestLiveScheduledBot(t *testing.T) {
+	client := newLiveClient(t)
+	meetingURL := liveEnv(t, "RECALLAI_LIVE_MEETING_URL")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// Schedule the bot far enough ahead that it never joins.
+	request := &recallaigo.CreateBotRequest{
+		MeetingURL: meetingURL,
+		BotName:    "recallai-go live test",
+		JoinAt:     recallaigo.ScheduleIn(24 * time.Hour),
+	}
+	bot, err := client.Bot.CreateBot(ctx, request)
+	if err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Bot.DeleteScheduledBot(context.Background(), bot.ID); err != nil {
+			t.Errorf("DeleteScheduledBot() error = %v", err)
+		}
+	})
+
+	request.BotName = "recallai-go live test (updated)"
+	updated, err := client.Bot.UpdateScheduledBot(ctx, bot.ID, request)
+	if err != nil {
+		t.Fatalf("UpdateScheduledBot() error = %v", err)
+	}
+	if updated.BotName != request.BotName {
+		t.Errorf("UpdateScheduledBot() bot name = %q, want %q", updated.BotName, request.BotName)
+	}
+}
+
+// TestLiveUpdateFixtures refreshes the read-only fixtures in test_data with sanitized responses
+// of a real bot, keeping them close to the current API. Review the diff before committing it.
+func TestLiveUpdateFixtures(t *testing.T) {
+	newLiveClient(t)
+	liveEnv(t, "RECALLAI_UPDATE_FIXTURES")
+	botID := liveEnv(t, "RECALLAI_LIVE_BOT_ID")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	var opts []recallaigo.ClientOption
+	if region := os.Getenv("RECALLAI_REGION"); region != "" {
+		opts = append(opts, recallaigo.WithRegion(recallaigo.Region(region)))
+	}
+	if err := recalltest.GenerateFixtures(ctx, os.Getenv("RECALLAI_API_KEY"), botID, "test_data", opts...); err != nil {
+		t.Fatalf("GenerateFixtures() error = %v", err)
+	}
+}
@@ -0,0 +1,326 @@
+package recallaigo
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type MediaService interface {
+	Download(ctx context.Context, url string, w io.Writer, opts ...DownloadOptions) (int64, error)
+	DownloadVideo(ctx context.Context, bot *Bot, w io.Writer, opts ...DownloadOptions) (int64, error)
+	DownloadToSink(ctx context.Context, url string, sink BlobSink, key string, opts ...DownloadOptions) error
+	DownloadFile(ctx context.Context, url string, name string, opts ...DownloadOptions) error
+	ListAudioMixed(ctx context.Context, params *ListAudioMixedParams) (*ListAudioMixedResponse, error)
+	OpenMixedAudio(ctx context.Context, recordingID string) (io.ReadCloser, error)
+	DownloadScreenshots(ctx context.Context, botID string, sink BlobSink, opts ...DownloadScreenshotsOptions) ([]string, error)
+	ExportMeetingBundle(ctx context.Context, botID string, sink BlobSink, opts ...MeetingBundleOptions) (*MeetingBundleManifest, error)
+}
+
+type MediaClient struct {
+	client *Client
+}
+
+// DownloadOptions configures the verification of a download.
+// Checks from the options are applied in addition to the ones advertised by the server
+// through the Content-Length, Content-MD5 and x-amz-checksum-sha256 headers. Composite
+// x-amz-checksum-sha256 values of multipart uploads are not checked.
+type DownloadOptions struct {
+	// The expected size of the content in bytes.
+	ExpectedSize int64
+	// The expected SHA-256 checksum of the content, hex encoded.
+	ExpectedSHA256 string
+	// The size in bytes of the ranges fetched in parallel. Chunked downloads are enabled
+	// when both ChunkSize and Parallelism are set and the server supports range requests.
+	ChunkSize int64
+	// The maximum number of ranges fetched at the same time.
+	Parallelism int
+}
+
+// IntegrityError is returned when downloaded content does not match its expected size or checksum.
+// The content has already been written to the destination and must be discarded.
+type IntegrityError struct {
+	URL string
+	// The check that failed: "size", "md5" or "sha256".
+	Check    string
+	Expected string
+	Actual   string
+}
+
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("integrity check %s failed for %s: expected %s, got %s", e.Check, redactURL(e.URL), e.Expected, e.Actual)
+}
+
+// redactURL strips the query string, which holds the signature of pre-signed media URLs.
+func redactURL(rawURL string) string {
+	if i := strings.IndexByte(rawURL, '?'); i >= 0 {
+		return rawURL[:i]
+	}
+	return rawURL
+}
+
+// Download streams the content at url into w and verifies its size and checksums.
+// Media URLs returned by the API are pre-signed, so no authorization header is sent to them.
+// It returns the number of bytes written.
+func (c *MediaClient) Download(ctx context.Context, url string, w io.Writer, opts ...DownloadOptions) (int64, error) {
+	var opt DownloadOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	if opt.ChunkSize > 0 && opt.Parallelism > 1 {
+		return c.downloadChunked(ctx, url, w, opt)
+	}
+
+	res, err := c.client.requestRaw(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download media: %w", err)
+	}
+	defer res.Body.Close()
+
+	return copyVerified(w, res, url, opt)
+}
+
+// DownloadToSink streams the content at url into the sink under the given key.
+// If the download fails or does not pass verification, the reader passed to the sink
+// returns the error instead of io.EOF so the sink can discard the blob.
+func (c *MediaClient) DownloadToSink(ctx context.Context, url string, sink BlobSink, key string, opts ...DownloadOptions) error {
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := c.Download(ctx, url, pw, opts...)
+		pw.CloseWithError(err)
+	}()
+
+	err := sink.Put(ctx, key, pr)
+	// Unblock the download if the sink stopped reading early.
+	pr.CloseWithError(err)
+	if err != nil {
+		return fmt.Errorf("failed to store %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// DownloadFile downloads the content at url into the named file.
+// The file only appears once the download completed and passed verification;
+// a failed or cancelled download leaves no partial file behind.
+func (c *MediaClient) DownloadFile(ctx context.Context, url string, name string, opts ...DownloadOptions) error {
+	dir, base := filepath.Split(name)
+	return c.DownloadToSink(ctx, url, DirSink{Dir: dir}, base, opts...)
+}
+
+// DownloadVideo downloads the video recording of the bot.
+// see https://docs.recall.ai/docs/download-urls
+func (c *MediaClient) DownloadVideo(ctx context.Context, bot *Bot, w io.Writer, opts ...DownloadOptions) (int64, error) {
+	if bot == nil || bot.VideoURL == "" {
+		return 0, fmt.Errorf("bot has no video URL")
+	}
+
+	n, err := c.Download(ctx, bot.VideoURL, w, opts...)
+	if err != nil {
+		return n, fmt.Errorf("failed to download video: %w", err)
+	}
+
+	return n, nil
+}
+
+// copyVerified copies the response body into w while hashing it, then checks the result
+// against the expected values from the options and the response headers.
+func copyVerified(w io.Writer, res *http.Response, url string, opt DownloadOptions) (int64, error) {
+	v := newVerifier(url, opt)
+
+	n, err := io.Copy(io.MultiWriter(w, v), res.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.Uncompressed {
+		// Content-Length and Content-MD5 describe the compressed representation.
+		return n, v.verify(-1, "", fullObjectChecksum(res.Header))
+	}
+	return n, v.verify(res.ContentLength, res.Header.Get("Content-MD5"), fullObjectChecksum(res.Header))
+}
+
+// fullObjectChecksum returns the x-amz-checksum-sha256 header if it is the checksum of the whole
+// content. Composite checksums of multipart uploads hash the checksums of the parts instead, so
+// they cannot be verified against the content and are skipped. They end in a "-N" part count,
+// which the base64 alphabet of full checksums lacks, or are marked by x-amz-checksum-type.
+func fullObjectChecksum(header http.Header) string {
+	checksum := header.Get("x-amz-checksum-sha256")
+	if strings.Contains(checksum, "-") || strings.EqualFold(header.Get("x-amz-checksum-type"), "COMPOSITE") {
+		return ""
+	}
+	return checksum
+}
+
+// verifier hashes content as it is written and checks it against the expected values.
+type verifier struct {
+	url    string
+	opt    DownloadOptions
+	n      int64
+	md5    hash.Hash
+	sha256 hash.Hash
+}
+
+func newVerifier(url string, opt DownloadOptions) *verifier {
+	return &verifier{
+		url:    url,
+		opt:    opt,
+		md5:    md5.New(),
+		sha256: sha256.New(),
+	}
+}
+
+func (v *verifier) Write(p []byte) (int, error) {
+	v.md5.Write(p)
+	v.sha256.Write(p)
+	v.n += int64(len(p))
+	return len(p), nil
+}
+
+// verify checks the written content against the options and the values advertised by the server.
+// Empty or negative server values are skipped.
+func (v *verifier) verify(contentLength int64, contentMD5, checksumSHA256 string) error {
+	expectedSize := v.opt.ExpectedSize
+	if expectedSize <= 0 {
+		expectedSize = contentLength
+	}
+	if expectedSize > 0 && v.n != expectedSize {
+		return &IntegrityError{
+			URL:      v.url,
+			Check:    "size",
+			Expected: strconv.FormatInt(expectedSize, 10),
+			Actual:   strconv.FormatInt(v.n, 10),
+		}
+	}
+
+	if expected := v.opt.ExpectedSHA256; expected != "" {
+		if err := verifyHash(v.url, "sha256", strings.ToLower(expected), v.sha256, hex.EncodeToString); err != nil {
+			return err
+		}
+	}
+	if checksumSHA256 != "" {
+		if err := verifyHash(v.url, "sha256", checksumSHA256, v.sha256, base64.StdEncoding.EncodeToString); err != nil {
+			return err
+		}
+	}
+	if contentMD5 != "" {
+		if err := verifyHash(v.url, "md5", contentMD5, v.md5, base64.StdEncoding.EncodeToString); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func verifyHash(url, check, expected string, h hash.Hash, encode func([]byte) string) error {
+	if actual := encode(h.Sum(nil)); actual != expected {
+		return &IntegrityError{URL: url, Check: check, Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// ArtifactStatus is the processing status of a media artifact.
+type ArtifactStatus struct {
+	Code      string `json:"code"`
+	SubCode   string `json:"sub_code"`
+	UpdatedAt Time   `json:"updated_at"`
+}
+
+// ArtifactData holds the location of the content of a media artifact.
+// Which URLs are set depends on the kind of artifact.
+type ArtifactData struct {
+	DownloadURL string `json:"download_url"`
+	// The transcript in the format of the transcription provider.
+	ProviderDataDownloadURL string `json:"provider_data_download_url,omitempty"`
+	// The participant events, speaker timeline and participant list of participant_events artifacts.
+	ParticipantEventsDownloadURL string `json:"participant_events_download_url,omitempty"`
+	SpeakerTimelineDownloadURL   string `json:"speaker_timeline_download_url,omitempty"`
+	ParticipantsDownloadURL      string `json:"participants_download_url,omitempty"`
+}
+
+// AudioMixed is the mixed audio artifact of a recording.
+type AudioMixed = MediaArtifact
+
+// MediaArtifact is a media artifact of a recording, such as its mixed video, audio or transcript.
+type MediaArtifact struct {
+	ID        string         `json:"id"`
+	CreatedAt Time           `json:"created_at"`
+	Status    ArtifactStatus `json:"status"`
+	Metadata  Metadata       `json:"metadata,omitempty"`
+	Data      ArtifactData   `json:"data"`
+	Format    string         `json:"format"`
+}
+
+// ListAudioMixedParams defines the parameters for filtering the list of mixed audio artifacts.
+type ListAudioMixedParams struct {
+	// Filter artifacts by recording ID
+	RecordingID string
+	// Specify the cursor for pagination
+	Cursor string
+}
+
+// ListAudioMixedResponse represents the response body for the ListAudioMixed method
+type ListAudioMixedResponse struct {
+	Next     string       `json:"next"`
+	Previous string       `json:"previous"`
+	Results  []AudioMixed `json:"results"`
+}
+
+// ListAudioMixed lists the mixed audio artifacts.
+// see https://docs.recall.ai/reference/audio_mixed_list
+func (c *MediaClient) ListAudioMixed(ctx context.Context, params *ListAudioMixedParams) (*ListAudioMixedResponse, error) {
+	// Prepare query parameters
+	q := &query{}
+	if params != nil {
+		q.addString("recording_id", params.RecordingID)
+		q.addString("cursor", params.Cursor)
+	}
+
+	// Make the request
+	res, err := c.client.request(ctx, http.MethodGet, "audio_mixed", q, nil, APIVersionV1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mixed audio: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Decode the response
+	var response ListAudioMixedResponse
+	if err := c.client.decode(res, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// OpenMixedAudio opens a stream of the mixed audio of the recording.
+// The caller must close the returned reader.
+func (c *MediaClient) OpenMixedAudio(ctx context.Context, recordingID string) (io.ReadCloser, error) {
+	list, err := c.ListAudioMixed(ctx, &ListAudioMixedParams{RecordingID: recordingID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, audio := range list.Results {
+		if audio.Data.DownloadURL == "" {
+			continue
+		}
+
+		res, err := c.client.requestRaw(ctx, http.MethodGet, audio.Data.DownloadURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open mixed audio: %w", err)
+		}
+		return res.Body, nil
+	}
+
+	return nil, fmt.Errorf("no mixed audio available for recording %s", recordingID)
+}
@@ -0,0 +1,139 @@
+package recallaigo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// chunk is the content of a single range of a chunked download.
+type chunk struct {
+	data []byte
+	err  error
+}
+
+// downloadChunked downloads the content with parallel range requests and writes the ranges to w
+// in order. At most opt.Parallelism ranges are held in memory at a time. If the server ignores
+// the range request, the full response of the first request is streamed instead.
+func (c *MediaClient) downloadChunked(ctx context.Context, url string, w io.Writer, opt DownloadOptions) (int64, error) {
+	first, err := c.fetchRange(ctx, url, 0, opt.ChunkSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download media: %w", err)
+	}
+	if first.StatusCode != http.StatusPartialContent {
+		defer first.Body.Close()
+		return copyVerified(w, first, url, opt)
+	}
+
+	total, err := contentRangeTotal(first.Header.Get("Content-Range"))
+	if err != nil {
+		first.Body.Close()
+		return 0, err
+	}
+
+	v := newVerifier(url, opt)
+	dst := io.MultiWriter(w, v)
+
+	n, err := io.Copy(dst, first.Body)
+	first.Body.Close()
+	if err != nil {
+		return n, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The producer starts range requests in order and queues their results. The semaphore
+	// limits the number of ranges being fetched or waiting to be written.
+	sem := make(chan struct{}, opt.Parallelism)
+	pending := make(chan chan chunk, opt.Parallelism)
+	go func() {
+		defer close(pending)
+		for start := opt.ChunkSize; start < total; start += opt.ChunkSize {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			result := make(chan chunk, 1)
+			pending <- result
+			go func(start int64) {
+				data, err := c.readRange(ctx, url, start, min(opt.ChunkSize, total-start))
+				result <- chunk{data: data, err: err}
+			}(start)
+		}
+	}()
+
+	for result := range pending {
+		chunk := <-result
+		<-sem
+		if chunk.err != nil {
+			return n, fmt.Errorf("failed to download media: %w", chunk.err)
+		}
+
+		written, err := dst.Write(chunk.data)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return n, err
+	}
+
+	return n, v.verify(total, "", "")
+}
+
+// fetchRange requests length bytes starting at start.
+func (c *MediaClient) fetchRange(ctx context.Context, url string, start, length int64) (*http.Response, error) {
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, start+length-1))
+	return c.client.requestRaw(ctx, http.MethodGet, url, header)
+}
+
+// readRange reads length bytes starting at start, failing if the server returns anything else.
+func (c *MediaClient) readRange(ctx context.Context, url string, start, length int64) ([]byte, error) {
+	res, err := c.fetchRange(ctx, url, start, length)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusPartialContent {
+		return nil, fmt.Errorf("unexpected status code for range request: %d", res.StatusCode)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if int64(len(data)) != length {
+		return nil, &IntegrityError{
+			URL:      url,
+			Check:    "size",
+			Expected: strconv.FormatInt(length, 10),
+			Actual:   strconv.Itoa(len(data)),
+		}
+	}
+
+	return data, nil
+}
+
+// contentRangeTotal returns the complete length from a Content-Range header like "bytes 0-99/1234".
+func contentRangeTotal(contentRange string) (int64, error) {
+	i := strings.LastIndexByte(contentRange, '/')
+	if i < 0 {
+		return 0, fmt.Errorf("invalid Content-Range header: %q", contentRange)
+	}
+
+	total, err := strconv.ParseInt(contentRange[i+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Content-Range header: %q", contentRange)
+	}
+
+	return total, nil
+}
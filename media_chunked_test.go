@@ -0,0 +1,67 @@
+package recallaigo_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+// newRangeResponse serves the requested range of content like object storage does.
+func newRangeResponse(req *http.Request, content string) *http.Response {
+	var start, end int
+	if _, err := fmt.Sscanf(req.Header.Get("Range"), "bytes=%d-%d", &start, &end); err != nil {
+		return testutil.NewStringResponse(content, http.StatusOK)
+	}
+	end = min(end, len(content)-1)
+
+	res := testutil.NewStringResponse(content[start:end+1], http.StatusPartialContent)
+	res.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+	return res
+}
+
+func TestDownloadChunked(t *testing.T) {
+	const content = "abcdefghijklmnopqrstuvwxyz"
+
+	tests := []struct {
+		name          string
+		supportsRange bool
+	}{
+		{
+			name:          "reassembles ranges in order",
+			supportsRange: true,
+		},
+		{
+			name:          "falls back without range support",
+			supportsRange: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				if !tt.supportsRange {
+					return testutil.NewStringResponse(content, http.StatusOK)
+				}
+				return newRangeResponse(req, content)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			var buf bytes.Buffer
+			n, err := client.Media.Download(context.Background(), "https://media.test/video.mp4", &buf, recallaigo.DownloadOptions{
+				ChunkSize:   4,
+				Parallelism: 3,
+			})
+			if err != nil {
+				t.Fatalf("Download() error = %v", err)
+			}
+			if n != int64(len(content)) || buf.String() != content {
+				t.Errorf("Download() wrote %d bytes %q, want %q", n, buf.String(), content)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// defaultScreenshotFilenameTemplate names screenshots by their capture time.
+const defaultScreenshotFilenameTemplate = `{{.RecordedAt.UTC.Format "20060102T150405.000Z"}}_{{.ID}}{{.Ext}}`
+
+// ScreenshotFile is the data available to the screenshot filename template.
+type ScreenshotFile struct {
+	ID string
+	// The position of the screenshot in the list, starting at 0.
+	Index      int
+	RecordedAt time.Time
+	// The file extension of the image, including the dot.
+	Ext string
+}
+
+// DownloadScreenshotsOptions configures the DownloadScreenshots method.
+type DownloadScreenshotsOptions struct {
+	// The maximum number of screenshots downloaded at the same time. Defaults to 5.
+	Concurrency int
+	// A text/template executed with a ScreenshotFile to name each file.
+	// Defaults to the capture time and ID, e.g. "20250318T101310.433Z_<id>.png".
+	FilenameTemplate string
+}
+
+// DownloadScreenshots downloads every screenshot of the bot into the sink.
+// Failed downloads do not stop the others; the names of the written files are returned
+// together with the joined errors.
+func (c *MediaClient) DownloadScreenshots(ctx context.Context, botID string, sink BlobSink, opts ...DownloadScreenshotsOptions) ([]string, error) {
+	var opt DownloadScreenshotsOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Concurrency <= 0 {
+		opt.Concurrency = defaultBatchConcurrency
+	}
+	if opt.FilenameTemplate == "" {
+		opt.FilenameTemplate = defaultScreenshotFilenameTemplate
+	}
+
+	tmpl, err := template.New("filename").Parse(opt.FilenameTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filename template: %w", err)
+	}
+
+	screenshots, err := c.listAllScreenshots(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		names []string
+		errs  []error
+	)
+	indexes := make(chan int)
+	for w := 0; w < opt.Concurrency && w < len(screenshots); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				name, err := c.downloadScreenshot(ctx, tmpl, i, screenshots[i], sink)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("failed to download screenshot %s: %w", screenshots[i].ID, err))
+				} else {
+					names = append(names, name)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := range screenshots {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return names, errors.Join(errs...)
+}
+
+func (c *MediaClient) listAllScreenshots(ctx context.Context, botID string) ([]Screenshot, error) {
+	var (
+		screenshots []Screenshot
+		params      ListBotScreenshotsParams
+	)
+	for {
+		page, err := c.client.Bot.ListBotScreenshots(ctx, botID, params)
+		if err != nil {
+			return nil, err
+		}
+		screenshots = append(screenshots, page.Results...)
+
+		params.Cursor = cursorFromURL(page.Next)
+		if params.Cursor == "" {
+			return screenshots, nil
+		}
+	}
+}
+
+func (c *MediaClient) downloadScreenshot(ctx context.Context, tmpl *template.Template, index int, screenshot Screenshot, sink BlobSink) (string, error) {
+	file := ScreenshotFile{
+		ID:         screenshot.ID,
+		Index:      index,
+		RecordedAt: screenshot.RecordedAt.Time,
+		Ext:        ".png",
+	}
+	if u, err := url.Parse(screenshot.Image); err == nil && path.Ext(u.Path) != "" {
+		file.Ext = path.Ext(u.Path)
+	}
+
+	var name strings.Builder
+	if err := tmpl.Execute(&name, file); err != nil {
+		return "", fmt.Errorf("failed to build filename: %w", err)
+	}
+
+	if err := c.DownloadToSink(ctx, screenshot.Image, sink, name.String()); err != nil {
+		return "", err
+	}
+
+	return name.String(), nil
+}
+
+// cursorFromURL extracts the pagination cursor from a next or previous page URL.
+func cursorFromURL(pageURL string) string {
+	if pageURL == "" {
+		return ""
+	}
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("cursor")
+}
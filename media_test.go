@@ -0,0 +1,164 @@
+package recallaigo_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestMediaClient(t *testing.T) {
+	t.Run("Download", func(t *testing.T) {
+		const content = "recording"
+
+		tests := []struct {
+			name          string
+			contentLength int64
+			header        http.Header
+			opts          recallaigo.DownloadOptions
+			wantCheck     string
+		}{
+			{
+				name:          "detects checksum mismatch",
+				contentLength: int64(len(content)),
+				opts: recallaigo.DownloadOptions{
+					ExpectedSHA256: "a36e6c6e4f2a8bd1fd2d4ebcb4c8b1db8cdbc59e02d2ebc7b3c7dcb6b0e0d0a1",
+				},
+				wantCheck: "sha256",
+			},
+			{
+				name:          "detects truncated content",
+				contentLength: int64(len(content)) + 10,
+				wantCheck:     "size",
+			},
+			{
+				name:          "accepts unknown length",
+				contentLength: -1,
+			},
+			{
+				name:          "detects full object checksum mismatch",
+				contentLength: int64(len(content)),
+				header:        http.Header{"X-Amz-Checksum-Sha256": {"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="}},
+				wantCheck:     "sha256",
+			},
+			{
+				name:          "skips composite checksums",
+				contentLength: int64(len(content)),
+				header:        http.Header{"X-Amz-Checksum-Sha256": {"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=-3"}},
+			},
+			{
+				name:          "skips checksums marked composite",
+				contentLength: int64(len(content)),
+				header: http.Header{
+					"X-Amz-Checksum-Sha256": {"47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="},
+					"X-Amz-Checksum-Type":   {"COMPOSITE"},
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				c := testutil.NewTestClient(func(*http.Request) *http.Response {
+					header := tt.header
+					if header == nil {
+						header = make(http.Header)
+					}
+					return &http.Response{
+						StatusCode:    http.StatusOK,
+						Body:          io.NopCloser(strings.NewReader(content)),
+						ContentLength: tt.contentLength,
+						Header:        header,
+					}
+				})
+				client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+				var buf bytes.Buffer
+				_, err := client.Media.Download(context.Background(), "https://media.test/video.mp4?sig=secret", &buf, tt.opts)
+
+				var integrityErr *recallaigo.IntegrityError
+				if tt.wantCheck == "" {
+					if err != nil {
+						t.Fatalf("Download() error = %v", err)
+					}
+					if buf.String() != content {
+						t.Errorf("Download() wrote %q, want %q", buf.String(), content)
+					}
+					return
+				}
+				if !errors.As(err, &integrityErr) {
+					t.Fatalf("Download() error = %v, want IntegrityError", err)
+				}
+				if integrityErr.Check != tt.wantCheck {
+					t.Errorf("Download() check = %s, want %s", integrityErr.Check, tt.wantCheck)
+				}
+				if strings.Contains(err.Error(), "secret") {
+					t.Error("Download() error contains the URL signature")
+				}
+			})
+		}
+	})
+	t.Run("OpenMixedAudio", func(t *testing.T) {
+		c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+			if req.URL.Host == "media.test" {
+				return testutil.NewStringResponse("audio", http.StatusOK)
+			}
+			if got := req.URL.Query().Get("recording_id"); got != "recording" {
+				t.Errorf("OpenMixedAudio() recording_id = %s, want recording", got)
+			}
+			return testutil.NewFileResponse(t, "test_data/list_audio_mixed.json", http.StatusOK)
+		})
+		client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+		r, err := client.Media.OpenMixedAudio(context.Background(), "recording")
+		if err != nil {
+			t.Fatalf("OpenMixedAudio() error = %v", err)
+		}
+		defer r.Close()
+
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "audio" {
+			t.Errorf("OpenMixedAudio() read %q, want %q", got, "audio")
+		}
+	})
+	t.Run("DownloadScreenshots", func(t *testing.T) {
+		c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+			if req.URL.Host == "media.test" {
+				return testutil.NewStringResponse(req.URL.Path, http.StatusOK)
+			}
+			return testutil.NewFileResponse(t, "test_data/list_bot_screenshots.json", http.StatusOK)
+		})
+		client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+		dir := t.TempDir()
+
+		names, err := client.Media.DownloadScreenshots(context.Background(), "bot", recallaigo.DirSink{Dir: dir}, recallaigo.DownloadScreenshotsOptions{
+			FilenameTemplate: "{{.Index}}{{.Ext}}",
+		})
+		if err != nil {
+			t.Fatalf("DownloadScreenshots() error = %v", err)
+		}
+
+		slices.Sort(names)
+		if want := []string{"0.jpg", "1.jpg"}; !slices.Equal(names, want) {
+			t.Fatalf("DownloadScreenshots() names = %v, want %v", names, want)
+		}
+		got, err := os.ReadFile(filepath.Join(dir, "1.jpg"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "/screenshot-2.jpg" {
+			t.Errorf("DownloadScreenshots() wrote %q to 1.jpg", got)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package recallaigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// MeetingURL is the meeting URL of a bot. The API returns it either as an object with the parsed
+// meeting ID and platform, or as a plain string; both decode into a MeetingURL.
+type MeetingURL struct {
+	MeetingID       string  `json:"meeting_id,omitempty"`
+	MeetingPassword string  `json:"meeting_password,omitempty"`
+	TK              *string `json:"tk,omitempty"`
+	Platform        string  `json:"platform,omitempty"`
+	// The URL as returned by the API when it is a plain string. The other fields are parsed from it
+	// where the platform is recognized.
+	URL string `json:"-"`
+}
+
+// ParseMeetingURL parses a meeting URL, detecting the platform and, for Zoom and Google Meet,
+// the meeting ID and password.
+func ParseMeetingURL(s string) MeetingURL {
+	m := MeetingURL{URL: s}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return m
+	}
+	host := strings.ToLower(u.Hostname())
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch {
+	case host == "zoom.us" || strings.HasSuffix(host, ".zoom.us"):
+		m.Platform = string(PlatformZoom)
+		if len(segments) == 2 && (segments[0] == "j" || segments[0] == "w") {
+			m.MeetingID = segments[1]
+			m.MeetingPassword = u.Query().Get("pwd")
+		}
+	case host == "meet.google.com":
+		m.Platform = string(PlatformGoogleMeet)
+		m.MeetingID = segments[0]
+	case host == "teams.microsoft.com":
+		m.Platform = string(PlatformMicrosoftTeams)
+	case host == "teams.live.com":
+		// Meetings of personal Microsoft accounts, which are joined differently from work accounts.
+		m.Platform = string(PlatformMicrosoftTeamsLive)
+	case strings.HasSuffix(host, ".webex.com"):
+		m.Platform = string(PlatformWebex)
+	case host == "meet.goto.com" || host == "app.gotomeeting.com" || host == "global.gotomeeting.com":
+		m.Platform = string(PlatformGotoMeeting)
+	}
+
+	return m
+}
+
+// String returns the meeting URL. If the API returned the object form, the URL is rebuilt
+// for Zoom and Google Meet, and is empty for other platforms.
+func (m MeetingURL) String() string {
+	if m.URL != "" {
+		return m.URL
+	}
+	if m.MeetingID == "" {
+		return ""
+	}
+
+	switch Platform(m.Platform) {
+	case PlatformZoom:
+		u := url.URL{Scheme: "https", Host: "zoom.us", Path: "/j/" + m.MeetingID}
+		if m.MeetingPassword != "" {
+			u.RawQuery = url.Values{"pwd": {m.MeetingPassword}}.Encode()
+		}
+		return u.String()
+	case PlatformGoogleMeet:
+		return "https://meet.google.com/" + m.MeetingID
+	}
+	return ""
+}
+
+// MarshalJSON encodes the meeting URL in the shape it was decoded from.
+func (m MeetingURL) MarshalJSON() ([]byte, error) {
+	if m.URL != "" {
+		return json.Marshal(m.URL)
+	}
+
+	type meetingURL MeetingURL
+	return json.Marshal(meetingURL(m))
+}
+
+func (m *MeetingURL) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*m = MeetingURL{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*m = ParseMeetingURL(s)
+		return nil
+	}
+
+	type meetingURL MeetingURL
+	var v meetingURL
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = MeetingURL(v)
+	return nil
+}
+
+// checkFields checks the fields of a meeting URL given as an object for strict decoding.
+func (m *MeetingURL) checkFields(data []byte, path string) error {
+	type meetingURL MeetingURL
+	return checkFields(data, reflect.TypeFor[meetingURL](), path)
+}
@@ -0,0 +1,116 @@
+package recallaigo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestMeetingURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		json         string
+		wantString   string
+		wantPlatform string
+		wantID       string
+	}{
+		{
+			name:         "object",
+			json:         `{"meeting_id": "123", "meeting_password": "456", "platform": "zoom"}`,
+			wantString:   "https://zoom.us/j/123?pwd=456",
+			wantPlatform: "zoom",
+			wantID:       "123",
+		},
+		{
+			name:         "zoom string",
+			json:         `"https://us02web.zoom.us/j/123?pwd=456"`,
+			wantString:   "https://us02web.zoom.us/j/123?pwd=456",
+			wantPlatform: "zoom",
+			wantID:       "123",
+		},
+		{
+			name:         "google meet string",
+			json:         `"https://meet.google.com/abc-defg-hij"`,
+			wantString:   "https://meet.google.com/abc-defg-hij",
+			wantPlatform: "google_meet",
+			wantID:       "abc-defg-hij",
+		},
+		{
+			name:         "teams string",
+			json:         `"https://teams.microsoft.com/l/meetup-join/abc"`,
+			wantString:   "https://teams.microsoft.com/l/meetup-join/abc",
+			wantPlatform: "microsoft_teams",
+		},
+		{
+			name:         "teams live string",
+			json:         `"https://teams.live.com/meet/9876543210?p=abc"`,
+			wantString:   "https://teams.live.com/meet/9876543210?p=abc",
+			wantPlatform: "microsoft_teams_live",
+		},
+		{
+			name: "null",
+			json: `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got recallaigo.MeetingURL
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+
+			if got.String() != tt.wantString {
+				t.Errorf("String() = %q, want %q", got.String(), tt.wantString)
+			}
+			if got.Platform != tt.wantPlatform || got.MeetingID != tt.wantID {
+				t.Errorf("UnmarshalJSON() = %+v, want platform %q and meeting ID %q", got, tt.wantPlatform, tt.wantID)
+			}
+
+			if tt.json == "null" {
+				return
+			}
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			var again recallaigo.MeetingURL
+			if err := json.Unmarshal(data, &again); err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+			if again.String() != got.String() || (data[0] == '"') != (tt.json[0] == '"') {
+				t.Errorf("round trip = %s, want the shape of %s", data, tt.json)
+			}
+		})
+	}
+}
+
+func TestVariantFor(t *testing.T) {
+	defaults := recallaigo.Variant{
+		Zoom:               recallaigo.VariantWeb4Core,
+		MicrosoftTeams:     recallaigo.VariantNative,
+		MicrosoftTeamsLive: recallaigo.VariantWeb,
+	}
+
+	tests := []struct {
+		name       string
+		meetingURL string
+		want       *recallaigo.Variant
+	}{
+		{"zoom", "https://zoom.us/j/123", &recallaigo.Variant{Zoom: recallaigo.VariantWeb4Core}},
+		{"teams", "https://teams.microsoft.com/l/meetup-join/abc", &recallaigo.Variant{MicrosoftTeams: recallaigo.VariantNative}},
+		{"teams live", "https://teams.live.com/meet/9876543210", &recallaigo.Variant{MicrosoftTeamsLive: recallaigo.VariantWeb}},
+		{"no default for the platform", "https://meet.google.com/abc-defg-hij", nil},
+		{"unknown platform", "https://example.com/meeting", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := defaults.For(tt.meetingURL)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("For() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package recallaigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"math"
+	"strconv"
+)
+
+// Metadata holds arbitrary JSON key-value pairs attached to a bot or an artifact.
+// Values decode as string, json.Number, bool, nil, []any or map[string]any. Numbers are kept
+// as json.Number so that large integers such as epoch milliseconds or IDs keep their precision.
+type Metadata map[string]any
+
+func (m *Metadata) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v map[string]any
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// String returns the value of key if it is a string.
+func (m Metadata) String(key string) (string, bool) {
+	s, ok := m[key].(string)
+	return s, ok
+}
+
+// Bool returns the value of key if it is a boolean.
+func (m Metadata) Bool(key string) (bool, bool) {
+	b, ok := m[key].(bool)
+	return b, ok
+}
+
+// Float64 returns the value of key if it is a number.
+func (m Metadata) Float64(key string) (float64, bool) {
+	switch v := m[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Int64 returns the value of key if it is a whole number.
+func (m Metadata) Int64(key string) (int64, bool) {
+	switch v := m[key].(type) {
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case int64:
+		return v, true
+	case json.Number:
+		i, err := strconv.ParseInt(string(v), 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// Clone returns a deep copy of the metadata, including nested objects and arrays.
+func (m Metadata) Clone() Metadata {
+	if m == nil {
+		return nil
+	}
+	c := make(Metadata, len(m))
+	for k, v := range m {
+		c[k] = cloneJSONValue(v)
+	}
+	return c
+}
+
+func cloneJSONValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		c := maps.Clone(v)
+		for k, e := range c {
+			c[k] = cloneJSONValue(e)
+		}
+		return c
+	case Metadata:
+		return v.Clone()
+	case []any:
+		c := make([]any, len(v))
+		for i, e := range v {
+			c[i] = cloneJSONValue(e)
+		}
+		return c
+	}
+	return v
+}
@@ -0,0 +1,66 @@
+package recallaigo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestMetadata(t *testing.T) {
+	var bot recallaigo.Bot
+	err := json.Unmarshal([]byte(`{"metadata": {"customer": "acme", "seats": 12, "ratio": 0.5, "trial": true, "owner": {"id": 7}}}`), &bot)
+	if err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	m := bot.Metadata
+
+	if got, ok := m.String("customer"); !ok || got != "acme" {
+		t.Errorf("String() = %q, %v, want %q", got, ok, "acme")
+	}
+	if got, ok := m.Int64("seats"); !ok || got != 12 {
+		t.Errorf("Int64() = %d, %v, want 12", got, ok)
+	}
+	if _, ok := m.Int64("ratio"); ok {
+		t.Error("Int64() of a fraction is ok, want not ok")
+	}
+	if got, ok := m.Float64("ratio"); !ok || got != 0.5 {
+		t.Errorf("Float64() = %v, %v, want 0.5", got, ok)
+	}
+	if got, ok := m.Bool("trial"); !ok || !got {
+		t.Errorf("Bool() = %v, %v, want true", got, ok)
+	}
+	if _, ok := m.String("seats"); ok {
+		t.Error("String() of a number is ok, want not ok")
+	}
+
+	data, err := json.Marshal(recallaigo.CreateBotRequest{Metadata: m})
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	var request recallaigo.CreateBotRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if owner, ok := request.Metadata["owner"].(map[string]any); !ok || owner["id"] != json.Number("7") {
+		t.Errorf("round trip metadata = %v, want nested owner", request.Metadata)
+	}
+}
+
+func TestMetadataPrecision(t *testing.T) {
+	var m recallaigo.Metadata
+	if err := json.Unmarshal([]byte(`{"started_at_ms": 1742292790433123456}`), &m); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+
+	if got, ok := m.Int64("started_at_ms"); !ok || got != 1742292790433123456 {
+		t.Errorf("Int64() = %d, %v, want 1742292790433123456", got, ok)
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if string(data) != `{"started_at_ms":1742292790433123456}` {
+		t.Errorf("MarshalJSON() = %s, want unchanged number", data)
+	}
+}
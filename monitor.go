@@ -0,0 +1,194 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StuckBotAlert describes a bot that has been waiting to join a call for longer than allowed.
+type StuckBotAlert struct {
+	BotID string
+	// The status the bot is stuck in.
+	Status Status
+	// When the bot entered the status.
+	Since time.Time
+	// How long the bot has been in the status.
+	Duration time.Duration
+	// Whether the monitor removed the bot from the call.
+	Left bool
+	// The error returned when removing the bot from the call, if any.
+	LeaveErr error
+}
+
+// StuckBotMonitorOptions configures a StuckBotMonitor.
+type StuckBotMonitorOptions struct {
+	// How long a bot may stay in joining_call. Defaults to 5 minutes.
+	JoiningCallThreshold time.Duration
+	// How long a bot may stay in in_waiting_room. Defaults to 10 minutes.
+	WaitingRoomThreshold time.Duration
+	// The interval between checks when running. Defaults to 10 seconds.
+	PollInterval time.Duration
+	// Whether stuck bots are removed from the call.
+	AutoLeave bool
+	// Called once per stuck bot and status.
+	OnStuck func(alert StuckBotAlert)
+	// Called by Run with the error of each failed check, e.g. to log it.
+	OnError func(err error)
+	// The clock used to measure how long bots are stuck. Defaults to SystemClock.
+	Clock Clock
+}
+
+// StuckBotMonitor tracks bots that are trying to join a call and reports the ones that stay in
+// joining_call or in_waiting_room for longer than the configured thresholds.
+type StuckBotMonitor struct {
+	bots BotService
+	opts StuckBotMonitorOptions
+
+	mu      sync.Mutex
+	tracked map[string]Status
+}
+
+// NewStuckBotMonitor creates a monitor that uses the given bot service.
+func NewStuckBotMonitor(bots BotService, opts StuckBotMonitorOptions) *StuckBotMonitor {
+	if opts.JoiningCallThreshold <= 0 {
+		opts.JoiningCallThreshold = 5 * time.Minute
+	}
+	if opts.WaitingRoomThreshold <= 0 {
+		opts.WaitingRoomThreshold = 10 * time.Minute
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.Clock == nil {
+		opts.Clock = SystemClock{}
+	}
+
+	return &StuckBotMonitor{
+		bots:    bots,
+		opts:    opts,
+		tracked: make(map[string]Status),
+	}
+}
+
+// Track starts monitoring the bot.
+func (m *StuckBotMonitor) Track(botID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tracked[botID]; !ok {
+		m.tracked[botID] = ""
+	}
+}
+
+// Untrack stops monitoring the bot.
+func (m *StuckBotMonitor) Untrack(botID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tracked, botID)
+}
+
+// Tracked returns the IDs of the bots being monitored.
+func (m *StuckBotMonitor) Tracked() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ids := make([]string, 0, len(m.tracked))
+	for id := range m.tracked {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Run checks the tracked bots every poll interval until the context is cancelled,
+// reporting failed checks to OnError.
+func (m *StuckBotMonitor) Run(ctx context.Context) error {
+	ticker := m.opts.Clock.NewTicker(m.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Bots whose retrieval failed stay tracked, so the next tick checks them again.
+		if err := m.Check(ctx); err != nil && m.opts.OnError != nil && ctx.Err() == nil {
+			m.opts.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+// Check retrieves every tracked bot once, alerting on stuck bots.
+// Bots that joined the call or reached a terminal status are no longer tracked.
+func (m *StuckBotMonitor) Check(ctx context.Context) error {
+	var errs []error
+	for _, botID := range m.Tracked() {
+		if err := m.check(ctx, botID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *StuckBotMonitor) check(ctx context.Context, botID string) error {
+	bot, err := m.bots.RetrieveBot(ctx, botID)
+	if err != nil {
+		return fmt.Errorf("failed to check bot %s: %w", botID, err)
+	}
+
+	status := bot.CurrentStatus()
+	var threshold time.Duration
+	switch status {
+	case StatusJoiningCall:
+		threshold = m.opts.JoiningCallThreshold
+	case StatusInWaitingRoom:
+		threshold = m.opts.WaitingRoomThreshold
+	case "", StatusReady:
+		return nil
+	default:
+		m.Untrack(botID)
+		return nil
+	}
+
+	since := bot.LatestStatusChange().CreatedAt.Time
+	if since.IsZero() {
+		return fmt.Errorf("missing status change time of bot %s", botID)
+	}
+	elapsed := m.opts.Clock.Now().Sub(since)
+	if elapsed < threshold || m.alerted(botID, status) {
+		return nil
+	}
+
+	alert := StuckBotAlert{
+		BotID:    botID,
+		Status:   status,
+		Since:    since,
+		Duration: elapsed,
+	}
+	if m.opts.AutoLeave {
+		_, alert.LeaveErr = m.bots.RemoveBotFromCall(ctx, botID)
+		alert.Left = alert.LeaveErr == nil
+		if alert.Left {
+			m.Untrack(botID)
+		}
+	}
+	if m.opts.OnStuck != nil {
+		m.opts.OnStuck(alert)
+	}
+
+	return nil
+}
+
+// alerted records that the bot has been reported in the status and returns whether it was already.
+func (m *StuckBotMonitor) alerted(botID string, status Status) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	previous, ok := m.tracked[botID]
+	if !ok || previous == status {
+		return true
+	}
+	m.tracked[botID] = status
+	return false
+}
@@ -0,0 +1,140 @@
+package recallaigo_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+func (f *fakeBotService) RetrieveBot(ctx context.Context, botID string) (*recallaigo.Bot, error) {
+	bot, ok := f.bots[botID]
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve bot: %w", &recallaigo.Error{StatusCode: http.StatusNotFound, Detail: "Not found."})
+	}
+	return bot, nil
+}
+
+func (f *fakeBotService) RemoveBotFromCall(ctx context.Context, botID string) (*recallaigo.Bot, error) {
+	bot, ok := f.bots[botID]
+	if !ok {
+		return nil, fmt.Errorf("bot %s not found", botID)
+	}
+	bot.StatusChanges = append(bot.StatusChanges, recallaigo.StatusChange{Code: "call_ended"})
+	return bot, nil
+}
+
+func botInStatus(id string, status recallaigo.Status, since time.Time) *recallaigo.Bot {
+	return &recallaigo.Bot{
+		ID: id,
+		StatusChanges: []recallaigo.StatusChange{
+			{Code: status, CreatedAt: recallaigo.Time{Time: since}},
+		},
+	}
+}
+
+func TestStuckBotMonitor(t *testing.T) {
+	now := time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		bot       *recallaigo.Bot
+		autoLeave bool
+		wantAlert bool
+		wantLeft  bool
+	}{
+		{
+			name:      "alerts on bot stuck joining",
+			bot:       botInStatus("bot", recallaigo.StatusJoiningCall, now.Add(-10*time.Minute)),
+			wantAlert: true,
+		},
+		{
+			name:      "removes bot stuck in waiting room",
+			bot:       botInStatus("bot", recallaigo.StatusInWaitingRoom, now.Add(-time.Hour)),
+			autoLeave: true,
+			wantAlert: true,
+			wantLeft:  true,
+		},
+		{
+			name: "ignores bot within threshold",
+			bot:  botInStatus("bot", recallaigo.StatusJoiningCall, now.Add(-time.Minute)),
+		},
+		{
+			name: "ignores bot in call",
+			bot:  botInStatus("bot", recallaigo.StatusInCallRecording, now.Add(-time.Hour)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bots := &fakeBotService{bots: map[string]*recallaigo.Bot{tt.bot.ID: tt.bot}}
+
+			var alerts []recallaigo.StuckBotAlert
+			monitor := recallaigo.NewStuckBotMonitor(bots, recallaigo.StuckBotMonitorOptions{
+				Clock:     recalltest.NewFakeClock(now),
+				AutoLeave: tt.autoLeave,
+				OnStuck: func(alert recallaigo.StuckBotAlert) {
+					alerts = append(alerts, alert)
+				},
+			})
+			monitor.Track(tt.bot.ID)
+
+			// A second check must not alert again for the same status.
+			for i := 0; i < 2; i++ {
+				if err := monitor.Check(context.Background()); err != nil {
+					t.Fatalf("Check() error = %v", err)
+				}
+			}
+
+			if got := len(alerts) == 1; got != tt.wantAlert {
+				t.Fatalf("Check() alerts = %d, wantAlert %v", len(alerts), tt.wantAlert)
+			}
+			if !tt.wantAlert {
+				return
+			}
+			if alerts[0].Left != tt.wantLeft {
+				t.Errorf("Check() left = %v, want %v", alerts[0].Left, tt.wantLeft)
+			}
+			if want := now.Sub(alerts[0].Since); alerts[0].Duration != want {
+				t.Errorf("Check() duration = %v, want %v", alerts[0].Duration, want)
+			}
+		})
+	}
+}
+
+// unavailableBotService fails to retrieve any bot, like an API that is down.
+type unavailableBotService struct {
+	*fakeBotService
+}
+
+func (unavailableBotService) RetrieveBot(ctx context.Context, botID string) (*recallaigo.Bot, error) {
+	return nil, fmt.Errorf("failed to retrieve bot: %w", &recallaigo.Error{StatusCode: http.StatusServiceUnavailable})
+}
+
+func TestStuckBotMonitorRunReportsErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errs []error
+	monitor := recallaigo.NewStuckBotMonitor(unavailableBotService{}, recallaigo.StuckBotMonitorOptions{
+		OnError: func(err error) {
+			errs = append(errs, err)
+			cancel()
+		},
+	})
+	monitor.Track("bot")
+
+	if err := monitor.Run(ctx); err != context.Canceled {
+		t.Fatalf("Run() error = %v, want %v", err, context.Canceled)
+	}
+	if len(errs) != 1 {
+		t.Errorf("OnError() called with %v, want one error", errs)
+	}
+	if got := monitor.Tracked(); len(got) != 1 {
+		t.Errorf("Tracked() = %v, want the bot still tracked", got)
+	}
+}
@@ -0,0 +1,157 @@
+package recallaigo
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ErrInvalidMP3 is returned by the OutputAudio helpers for audio that is not MP3.
+var ErrInvalidMP3 = errors.New("invalid MP3 audio")
+
+// ErrAudioTooLarge is returned by the OutputAudio helpers for audio beyond their limits.
+var ErrAudioTooLarge = errors.New("audio too large")
+
+// OutputAudioOptions configures the OutputAudioFromFile and OutputAudioFromReader methods.
+type OutputAudioOptions struct {
+	// The maximum size of the audio in bytes. Defaults to 10 MiB.
+	MaxBytes int64
+	// The maximum duration of the audio. Defaults to 5 minutes.
+	MaxDuration time.Duration
+}
+
+// OutputAudioFromFile causes the bot to output the audio of an MP3 file.
+// It works like OutputAudioFromReader.
+func (c *BotClient) OutputAudioFromFile(ctx context.Context, botID string, name string, opts ...OutputAudioOptions) (*Bot, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audio: %w", err)
+	}
+	defer f.Close()
+
+	return c.OutputAudioFromReader(ctx, botID, f, opts...)
+}
+
+// OutputAudioFromReader causes the bot to output the MP3 audio read from r. The audio is checked
+// before it is sent: it fails with ErrInvalidMP3 if it is not a sequence of MP3 frames, and with
+// ErrAudioTooLarge if it exceeds the size or duration limits of the options.
+func (c *BotClient) OutputAudioFromReader(ctx context.Context, botID string, r io.Reader, opts ...OutputAudioOptions) (*Bot, error) {
+	var opt OutputAudioOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.MaxBytes <= 0 {
+		opt.MaxBytes = 10 << 20
+	}
+	if opt.MaxDuration <= 0 {
+		opt.MaxDuration = 5 * time.Minute
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, opt.MaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audio: %w", err)
+	}
+	if int64(len(data)) > opt.MaxBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", ErrAudioTooLarge, opt.MaxBytes)
+	}
+
+	duration, err := mp3Duration(data)
+	if err != nil {
+		return nil, err
+	}
+	if duration > opt.MaxDuration {
+		return nil, fmt.Errorf("%w: %s is longer than %s", ErrAudioTooLarge, duration.Round(time.Millisecond), opt.MaxDuration)
+	}
+
+	return c.OutputAudio(ctx, botID, &OutputAudioRequest{
+		Kind:    OutputAudioKindMp3,
+		B64Data: base64.StdEncoding.EncodeToString(data),
+	})
+}
+
+// The bitrates of MPEG layer III in kbit/s by bitrate index, for MPEG-1 and for MPEG-2 and 2.5.
+var (
+	mp3BitratesV1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mp3BitratesV2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+)
+
+// The sample rates in Hz by sample rate index, for the MPEG versions 2.5, reserved, 2 and 1.
+var mp3SampleRates = [4][3]int{
+	{11025, 12000, 8000},
+	{},
+	{22050, 24000, 16000},
+	{44100, 48000, 32000},
+}
+
+// mp3Duration returns the duration of MP3 audio, summing its frames. Leading ID3v2 tags are
+// skipped, and data after the last frame, such as an ID3v1 tag, is ignored.
+func mp3Duration(data []byte) (time.Duration, error) {
+	pos := 0
+	// Skip ID3v2 tags, whose size is stored in 4 bytes of 7 bits.
+	for len(data)-pos >= 10 && string(data[pos:pos+3]) == "ID3" {
+		flags := data[pos+5]
+		size := int(data[pos+6])<<21 | int(data[pos+7])<<14 | int(data[pos+8])<<7 | int(data[pos+9])
+		pos += 10 + size
+		if flags&0x10 != 0 {
+			// The tag has a footer.
+			pos += 10
+		}
+	}
+
+	var samples, frames int
+	sampleRate := 0
+	for len(data)-pos >= 4 {
+		length, frameSamples, rate, ok := parseMP3Frame(data[pos:])
+		if !ok {
+			break
+		}
+		if pos+length > len(data) {
+			// The last frame is truncated, which players tolerate.
+			break
+		}
+		if sampleRate == 0 {
+			sampleRate = rate
+		}
+		samples += frameSamples
+		frames++
+		pos += length
+	}
+
+	if frames == 0 {
+		return 0, ErrInvalidMP3
+	}
+	return time.Duration(samples) * time.Second / time.Duration(sampleRate), nil
+}
+
+// parseMP3Frame parses the header of an MPEG layer III frame, returning its length in bytes,
+// its number of samples and its sample rate.
+func parseMP3Frame(header []byte) (length, samples, sampleRate int, ok bool) {
+	if header[0] != 0xff || header[1]&0xe0 != 0xe0 {
+		return 0, 0, 0, false
+	}
+	version := header[1] >> 3 & 3
+	layer := header[1] >> 1 & 3
+	bitrateIndex := header[2] >> 4
+	sampleRateIndex := header[2] >> 2 & 3
+	padding := int(header[2] >> 1 & 1)
+	if version == 1 || layer != 1 || sampleRateIndex == 3 {
+		return 0, 0, 0, false
+	}
+
+	bitrate, samples := mp3BitratesV1[bitrateIndex], 1152
+	if version != 3 {
+		bitrate, samples = mp3BitratesV2[bitrateIndex], 576
+	}
+	if bitrate == 0 {
+		// Free format and invalid bitrates.
+		return 0, 0, 0, false
+	}
+
+	sampleRate = mp3SampleRates[version][sampleRateIndex]
+	length = samples/8*bitrate*1000/sampleRate + padding
+	return length, samples, sampleRate, true
+}
@@ -0,0 +1,102 @@
+package recallaigo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+// mp3Frames returns n silent frames of MPEG-1 layer III audio at 128 kbit/s and 44.1 kHz,
+// each 417 bytes long and 1152 samples, about 26 ms.
+func mp3Frames(n int) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+	return bytes.Repeat(frame, n)
+}
+
+func TestOutputAudioFromReader(t *testing.T) {
+	id3 := append([]byte("ID3\x04\x00\x00\x00\x00\x00\x05"), "TIT2x"...)
+	// An MPEG-2 layer III frame at 64 kbit/s and 22.05 kHz, 208 bytes long.
+	mpeg2 := make([]byte, 208)
+	copy(mpeg2, []byte{0xff, 0xf3, 0x80, 0x00})
+
+	tests := []struct {
+		name    string
+		data    []byte
+		opts    recallaigo.OutputAudioOptions
+		wantErr error
+	}{
+		{name: "outputs MP3 audio", data: mp3Frames(100)},
+		{name: "skips ID3 tags", data: append(id3, mp3Frames(10)...)},
+		{name: "ignores trailing tags", data: append(mp3Frames(10), "TAG"...)},
+		{name: "accepts MPEG-2 audio", data: bytes.Repeat(mpeg2, 10)},
+		{name: "rejects other formats", data: []byte("RIFF\x00\x00\x00\x00WAVEfmt "), wantErr: recallaigo.ErrInvalidMP3},
+		{name: "rejects tags without audio", data: id3, wantErr: recallaigo.ErrInvalidMP3},
+		{name: "rejects empty audio", wantErr: recallaigo.ErrInvalidMP3},
+		{
+			name:    "rejects large audio",
+			data:    mp3Frames(100),
+			opts:    recallaigo.OutputAudioOptions{MaxBytes: 40000},
+			wantErr: recallaigo.ErrAudioTooLarge,
+		},
+		{
+			name:    "rejects long audio",
+			data:    mp3Frames(100),
+			opts:    recallaigo.OutputAudioOptions{MaxDuration: 2 * time.Second},
+			wantErr: recallaigo.ErrAudioTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *recallaigo.OutputAudioRequest
+			router := testutil.NewRouter()
+			router.Handle("POST /api/v1/bot/{id}/output_audio", func(req *http.Request) *http.Response {
+				got = &recallaigo.OutputAudioRequest{}
+				json.NewDecoder(req.Body).Decode(got)
+				return testutil.NewFileResponse(t, "test_data/output_audio.json", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+			_, err := client.Bot.OutputAudioFromReader(context.Background(), "bot_id", bytes.NewReader(tt.data), tt.opts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OutputAudioFromReader() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if got != nil {
+					t.Error("OutputAudioFromReader() sent a request despite the error")
+				}
+				return
+			}
+			if got.Kind != recallaigo.OutputAudioKindMp3 || got.B64Data != base64.StdEncoding.EncodeToString(tt.data) {
+				t.Errorf("sent %s audio of %d base64 bytes", got.Kind, len(got.B64Data))
+			}
+		})
+	}
+}
+
+func TestOutputAudioFromFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "hello.mp3")
+	if err := os.WriteFile(name, mp3Frames(10), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := testutil.NewMockedClient(t, "test_data/output_audio.json", http.StatusOK)
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+	if _, err := client.Bot.OutputAudioFromFile(context.Background(), "bot_id", name); err != nil {
+		t.Errorf("OutputAudioFromFile() error = %v", err)
+	}
+	if _, err := client.Bot.OutputAudioFromFile(context.Background(), "bot_id", "test_data/missing.mp3"); err == nil {
+		t.Error("OutputAudioFromFile() error = nil for a missing file")
+	}
+}
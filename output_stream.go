@@ -0,0 +1,65 @@
+package recallaigo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonStreamer is implemented by request bodies that can write their JSON encoding while the
+// request is sent, e.g. base64-encoded media read from an io.Reader.
+type jsonStreamer interface {
+	// streamJSON returns the function writing the JSON body, or nil to marshal the body as usual.
+	streamJSON() func(w io.Writer) error
+}
+
+func (r *OutputAudioRequest) streamJSON() func(w io.Writer) error {
+	if r.Source == nil {
+		return nil
+	}
+	return func(w io.Writer) error {
+		return writeMediaJSON(w, string(r.Kind), r.Source)
+	}
+}
+
+func (r *OutputVideoRequest) streamJSON() func(w io.Writer) error {
+	if r.Source == nil {
+		return nil
+	}
+	return func(w io.Writer) error {
+		return writeMediaJSON(w, string(r.Kind), r.Source)
+	}
+}
+
+// writeMediaJSON writes the JSON of an output media request, base64-encoding src as b64_data.
+func writeMediaJSON(w io.Writer, kind string, src io.Reader) error {
+	k, err := json.Marshal(kind)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, `{"kind":%s,"b64_data":"`, k); err != nil {
+		return err
+	}
+
+	enc := base64.NewEncoder(base64.StdEncoding, w)
+	if _, err := io.Copy(enc, src); err != nil {
+		return fmt.Errorf("failed to read media source: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, `"}`)
+	return err
+}
+
+// newStreamBody returns a request body reading what write writes, as it is written.
+// The body cannot be rewound, so requests with it are not retried.
+func newStreamBody(write func(w io.Writer) error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(write(pw))
+	}()
+	return pr
+}
@@ -0,0 +1,103 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestOutputMediaSource(t *testing.T) {
+	media := strings.Repeat("some media content ", 10000)
+
+	tests := []struct {
+		name string
+		call func(client *recallaigo.Client) error
+		want any
+	}{
+		{
+			name: "OutputAudio",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.OutputAudio(context.Background(), "some_id", &recallaigo.OutputAudioRequest{
+					Kind:   recallaigo.OutputAudioKindMp3,
+					Source: strings.NewReader(media),
+				})
+				return err
+			},
+			want: recallaigo.OutputAudioRequest{
+				Kind:    recallaigo.OutputAudioKindMp3,
+				B64Data: base64.StdEncoding.EncodeToString([]byte(media)),
+			},
+		},
+		{
+			name: "StartScreenshare",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.StartScreenshare(context.Background(), "some_id", &recallaigo.OutputVideoRequest{
+					Kind:   "jpeg",
+					Source: strings.NewReader(media),
+				})
+				return err
+			},
+			want: recallaigo.OutputVideoRequest{
+				Kind:    "jpeg",
+				B64Data: base64.StdEncoding.EncodeToString([]byte(media)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				if req.ContentLength > 0 {
+					t.Errorf("ContentLength = %d, want a streamed body", req.ContentLength)
+				}
+				body, _ = io.ReadAll(req.Body)
+				return testutil.NewStringResponse(`{"id": "some_id"}`, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			if err := tt.call(client); err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+
+			want, err := json.Marshal(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != string(want) {
+				t.Errorf("body = %.100s..., want %.100s...", body, want)
+			}
+		})
+	}
+}
+
+func TestOutputMediaSourceNotRetried(t *testing.T) {
+	var attempts int
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		attempts++
+		io.Copy(io.Discard, req.Body)
+		return testutil.NewStringResponse(`{"detail": "Too many requests."}`, http.StatusTooManyRequests)
+	})
+	client := recallaigo.NewClient("some_token",
+		recallaigo.WithHTTPClient(c),
+		recallaigo.WithRetry(recallaigo.RetryPolicy{MaxRetries: 3, Backoff: recallaigo.NoBackoff}),
+	)
+
+	_, err := client.Bot.OutputAudio(context.Background(), "some_id", &recallaigo.OutputAudioRequest{
+		Kind:   recallaigo.OutputAudioKindMp3,
+		Source: strings.NewReader("some audio"),
+	})
+	if err == nil {
+		t.Fatal("OutputAudio() error = nil, want the rate limit error")
+	}
+	if attempts != 1 {
+		t.Errorf("sent %d requests, want 1", attempts)
+	}
+}
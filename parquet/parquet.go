@@ -0,0 +1,80 @@
+// Package recallaiparquet writes transcripts and speaker timelines as Parquet files.
+//
+// It is a separate module so the Parquet dependency stays optional for users of recallai-go.
+package recallaiparquet
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// UtteranceRow is a transcript utterance as stored in Parquet.
+type UtteranceRow struct {
+	BotID       string  `parquet:"bot_id"`
+	RecordingID string  `parquet:"recording_id"`
+	Speaker     string  `parquet:"speaker"`
+	SpeakerID   int64   `parquet:"speaker_id"`
+	Start       float64 `parquet:"start"`
+	End         float64 `parquet:"end"`
+	Text        string  `parquet:"text"`
+	Confidence  float64 `parquet:"confidence"`
+}
+
+// SpeakerTimelineRow is a speaker timeline event as stored in Parquet.
+type SpeakerTimelineRow struct {
+	BotID       string  `parquet:"bot_id"`
+	RecordingID string  `parquet:"recording_id"`
+	Name        string  `parquet:"name"`
+	UserID      int64   `parquet:"user_id"`
+	Timestamp   float64 `parquet:"timestamp"`
+}
+
+// WriteUtterances writes the utterances of the transcript to w as a Parquet file.
+func WriteUtterances(w io.Writer, botID, recordingID string, entries []recallaigo.TranscriptEntry) error {
+	utterances := recallaigo.Utterances(entries)
+	rows := make([]UtteranceRow, len(utterances))
+	for i, u := range utterances {
+		rows[i] = UtteranceRow{
+			BotID:       botID,
+			RecordingID: recordingID,
+			Speaker:     u.Speaker,
+			SpeakerID:   int64(u.SpeakerID),
+			Start:       u.Start,
+			End:         u.End,
+			Text:        u.Text,
+			Confidence:  u.Confidence,
+		}
+	}
+	return writeRows(w, rows)
+}
+
+// WriteSpeakerTimeline writes the speaker timeline events to w as a Parquet file.
+func WriteSpeakerTimeline(w io.Writer, botID, recordingID string, timeline []recallaigo.SpeakerTimelineEntry) error {
+	rows := make([]SpeakerTimelineRow, len(timeline))
+	for i, entry := range timeline {
+		rows[i] = SpeakerTimelineRow{
+			BotID:       botID,
+			RecordingID: recordingID,
+			Name:        entry.Name,
+			UserID:      int64(entry.UserID),
+			Timestamp:   entry.Timestamp,
+		}
+	}
+	return writeRows(w, rows)
+}
+
+func writeRows[T any](w io.Writer, rows []T) error {
+	pw := parquet.NewGenericWriter[T](w, parquet.Compression(&parquet.Snappy))
+	if _, err := pw.Write(rows); err != nil {
+		pw.Close()
+		return fmt.Errorf("failed to write parquet rows: %w", err)
+	}
+	if err := pw.Close(); err != nil {
+		return fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+	return nil
+}
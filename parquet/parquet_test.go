@@ -0,0 +1,57 @@
+package recallaiparquet_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	recallaiparquet "github.com/harrison-peng/recallai-go/parquet"
+)
+
+func TestWriteUtterances(t *testing.T) {
+	entries := []recallaigo.TranscriptEntry{{
+		Speaker:   "Alice",
+		SpeakerID: 1,
+		Words: []recallaigo.WordDetail{
+			{Text: "Hello", StartTimestamp: 1, EndTimestamp: 2, Confidence: 1},
+			{Text: "world", StartTimestamp: 2, EndTimestamp: 3, Confidence: 0.5},
+		},
+	}}
+
+	var buf bytes.Buffer
+	if err := recallaiparquet.WriteUtterances(&buf, "bot", "rec", entries); err != nil {
+		t.Fatalf("WriteUtterances() error = %v", err)
+	}
+
+	rows, err := parquet.Read[recallaiparquet.UtteranceRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to read parquet: %v", err)
+	}
+
+	want := recallaiparquet.UtteranceRow{BotID: "bot", RecordingID: "rec", Speaker: "Alice", SpeakerID: 1, Start: 1, End: 3, Text: "Hello world", Confidence: 0.75}
+	if len(rows) != 1 || rows[0] != want {
+		t.Errorf("WriteUtterances() rows = %+v, want %+v", rows, want)
+	}
+}
+
+func TestWriteSpeakerTimeline(t *testing.T) {
+	timeline := []recallaigo.SpeakerTimelineEntry{
+		{Name: "Alice", UserID: 1, Timestamp: 0.5},
+		{Name: "Bob", UserID: 2, Timestamp: 4},
+	}
+
+	var buf bytes.Buffer
+	if err := recallaiparquet.WriteSpeakerTimeline(&buf, "bot", "rec", timeline); err != nil {
+		t.Fatalf("WriteSpeakerTimeline() error = %v", err)
+	}
+
+	rows, err := parquet.Read[recallaiparquet.SpeakerTimelineRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to read parquet: %v", err)
+	}
+	if len(rows) != 2 || rows[1].Name != "Bob" || rows[1].Timestamp != 4 {
+		t.Errorf("WriteSpeakerTimeline() rows = %+v, want the timeline", rows)
+	}
+}
@@ -0,0 +1,110 @@
+package recallaigo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SubjectMatcher reports whether a participant of a meeting is the data subject of an export.
+// Senders of chat messages are matched as participants with their ID, name and extra data.
+type SubjectMatcher func(participant MeetingParticipant) bool
+
+// MatchParticipantName matches the participants with the given name, ignoring case.
+func MatchParticipantName(name string) SubjectMatcher {
+	return func(p MeetingParticipant) bool {
+		return strings.EqualFold(strings.TrimSpace(p.Name), strings.TrimSpace(name))
+	}
+}
+
+// MatchParticipantEmail matches the participants with the given email, ignoring case. Only some
+// platforms report the emails of participants, e.g. Slack.
+func MatchParticipantEmail(email string) SubjectMatcher {
+	return func(p MeetingParticipant) bool {
+		return p.ExtraData.Slack.Email != "" && strings.EqualFold(p.ExtraData.Slack.Email, email)
+	}
+}
+
+// PersonalDataExport holds the data of a bot referencing a data subject, e.g. for a GDPR
+// subject access request.
+type PersonalDataExport struct {
+	BotID      string    `json:"bot_id"`
+	ExportedAt time.Time `json:"exported_at"`
+	// The participant records of the subject, usually one per meeting join.
+	Participants []MeetingParticipant `json:"participants"`
+	// The transcript entries spoken by the subject.
+	Utterances []TranscriptEntry `json:"utterances"`
+	// The chat messages sent by the subject.
+	ChatMessages []Message `json:"chat_messages"`
+}
+
+// ExportPersonalData collects the participant records, transcript entries and chat messages of
+// the bot that reference the data subject matched by matches. Transcript entries are attributed
+// by the speaker ID of the matched participants, and chat messages by their sender.
+func (c *BotClient) ExportPersonalData(ctx context.Context, botID string, matches SubjectMatcher) (*PersonalDataExport, error) {
+	bot, err := c.RetrieveBot(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &PersonalDataExport{
+		BotID:        botID,
+		ExportedAt:   c.client.clock.Now().UTC(),
+		Participants: []MeetingParticipant{},
+		Utterances:   []TranscriptEntry{},
+		ChatMessages: []Message{},
+	}
+
+	speakers := make(map[int]bool)
+	for _, p := range bot.MeetingParticipants {
+		if matches(p) {
+			export.Participants = append(export.Participants, p)
+			speakers[p.ID] = true
+		}
+	}
+
+	transcript, err := c.GetBotTranscript(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range transcript {
+		if speakers[entry.SpeakerID] {
+			export.Utterances = append(export.Utterances, entry)
+		}
+	}
+
+	cursor := ""
+	for {
+		page, err := c.ListChatMessages(ctx, botID, ListChatMessagesParams{Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range page.Results {
+			sender := MeetingParticipant{
+				ID:        message.Sender.ID,
+				Name:      message.Sender.Name,
+				IsHost:    message.Sender.IsHost,
+				Platform:  message.Sender.Platform,
+				ExtraData: message.Sender.ExtraData,
+			}
+			if speakers[sender.ID] || matches(sender) {
+				export.ChatMessages = append(export.ChatMessages, message)
+			}
+		}
+
+		if page.Next == "" {
+			break
+		}
+		next, err := url.Parse(page.Next)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse next page URL: %w", err)
+		}
+		if cursor = next.Query().Get("cursor"); cursor == "" {
+			break
+		}
+	}
+
+	return export, nil
+}
@@ -0,0 +1,96 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestExportPersonalData(t *testing.T) {
+	router := testutil.NewRouter()
+	router.HandleString("GET /api/v1/bot/{id}", `{"id": "bot_id", "meeting_participants": [
+		{"id": 1, "name": "Alice"},
+		{"id": 2, "name": "Bob", "extra_data": {"slack": {"email": "bob@example.com"}}}
+	]}`, http.StatusOK)
+	router.HandleString("GET /api/v1/bot/{id}/transcript", `[
+		{"speaker": "Alice", "speaker_id": 1, "words": [{"text": "Hello", "start_timestamp": 0, "end_timestamp": 1}]},
+		{"speaker": "Bob", "speaker_id": 2, "words": [{"text": "Hi", "start_timestamp": 1, "end_timestamp": 2}]},
+		{"speaker": "Alice", "speaker_id": 1, "words": [{"text": "Bye", "start_timestamp": 2, "end_timestamp": 3}]}
+	]`, http.StatusOK)
+	router.Handle("GET /api/v1/bot/{id}/chat-messages", func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("cursor") == "" {
+			return testutil.NewStringResponse(`{"next": "https://us-east-1.recall.ai/api/v1/bot/bot_id/chat-messages?cursor=page2", "results": [
+				{"text": "from Alice", "sender": {"id": 1, "name": "Alice"}},
+				{"text": "from Bob", "sender": {"id": 2, "name": "Bob"}}
+			]}`, http.StatusOK)
+		}
+		return testutil.NewStringResponse(`{"next": null, "results": [
+			{"text": "from Alice on her phone", "sender": {"id": 99, "name": "alice"}},
+			{"text": "from Carol", "sender": {"id": 3, "name": "Carol"}}
+		]}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+	tests := []struct {
+		name             string
+		matches          recallaigo.SubjectMatcher
+		wantParticipants []int
+		wantUtterances   []string
+		wantMessages     []string
+	}{
+		{
+			name:             "matches by name",
+			matches:          recallaigo.MatchParticipantName("Alice"),
+			wantParticipants: []int{1},
+			wantUtterances:   []string{"Hello", "Bye"},
+			wantMessages:     []string{"from Alice", "from Alice on her phone"},
+		},
+		{
+			name:             "matches by email",
+			matches:          recallaigo.MatchParticipantEmail("BOB@example.com"),
+			wantParticipants: []int{2},
+			wantUtterances:   []string{"Hi"},
+			wantMessages:     []string{"from Bob"},
+		},
+		{
+			name:    "exports nothing for unknown subjects",
+			matches: recallaigo.MatchParticipantName("Dave"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := client.Bot.ExportPersonalData(context.Background(), "bot_id", tt.matches)
+			if err != nil {
+				t.Fatalf("ExportPersonalData() error = %v", err)
+			}
+
+			var participants []int
+			for _, p := range export.Participants {
+				participants = append(participants, p.ID)
+			}
+			var utterances []string
+			for _, entry := range export.Utterances {
+				utterances = append(utterances, entry.Words[0].Text)
+			}
+			var messages []string
+			for _, message := range export.ChatMessages {
+				messages = append(messages, message.Text)
+			}
+
+			if !slices.Equal(participants, tt.wantParticipants) {
+				t.Errorf("participants = %v, want %v", participants, tt.wantParticipants)
+			}
+			if !slices.Equal(utterances, tt.wantUtterances) {
+				t.Errorf("utterances = %v, want %v", utterances, tt.wantUtterances)
+			}
+			if !slices.Equal(messages, tt.wantMessages) {
+				t.Errorf("messages = %v, want %v", messages, tt.wantMessages)
+			}
+		})
+	}
+}
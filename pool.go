@@ -0,0 +1,180 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BotPoolOptions configures a BotPool.
+type BotPoolOptions struct {
+	// The maximum number of bots that may be active at the same time. Required.
+	MaxConcurrent int
+	// The interval between refreshes when running. Defaults to 10 seconds.
+	PollInterval time.Duration
+	// The clock of the refresh ticker. Defaults to SystemClock.
+	Clock Clock
+	// Called by Run with the error of each failed refresh, e.g. to log it.
+	OnError func(err error)
+}
+
+// BotPoolStats is a snapshot of the pool usage.
+type BotPoolStats struct {
+	// The maximum number of active bots.
+	Limit int
+	// The number of slots currently held, by active bots and by CreateBot calls in flight.
+	Active int
+	// The number of CreateBot calls waiting for a slot.
+	Waiting int
+	// The total number of bots created through the pool.
+	Created int
+	// The total number of slots released.
+	Released int
+	// The total number of CreateBot calls that failed.
+	Failed int
+}
+
+// BotPool enforces a limit on the number of concurrently active bots.
+// CreateBot waits for a free slot, and slots are released once bots reach a terminal status.
+type BotPool struct {
+	bots  BotService
+	opts  BotPoolOptions
+	slots chan struct{}
+
+	mu     sync.Mutex
+	active map[string]struct{}
+	// The number of CreateBot calls holding a slot while they create their bot.
+	creating int
+	stats    BotPoolStats
+}
+
+// NewBotPool creates a pool that uses the given bot service.
+func NewBotPool(bots BotService, opts BotPoolOptions) (*BotPool, error) {
+	if opts.MaxConcurrent <= 0 {
+		return nil, fmt.Errorf("max concurrent must be positive")
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.Clock == nil {
+		opts.Clock = SystemClock{}
+	}
+
+	return &BotPool{
+		bots:   bots,
+		opts:   opts,
+		slots:  make(chan struct{}, opts.MaxConcurrent),
+		active: make(map[string]struct{}),
+		stats:  BotPoolStats{Limit: opts.MaxConcurrent},
+	}, nil
+}
+
+// CreateBot waits for a free slot and creates the bot.
+// The slot is held until the bot reaches a terminal status or Release is called.
+func (p *BotPool) CreateBot(ctx context.Context, request *CreateBotRequest) (*Bot, error) {
+	p.mu.Lock()
+	p.stats.Waiting++
+	p.mu.Unlock()
+
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		p.mu.Lock()
+		p.stats.Waiting--
+		p.mu.Unlock()
+		return nil, fmt.Errorf("failed to acquire bot slot: %w", ctx.Err())
+	}
+
+	p.mu.Lock()
+	p.stats.Waiting--
+	p.creating++
+	p.mu.Unlock()
+
+	bot, err := p.bots.CreateBot(ctx, request)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.creating--
+	if err != nil {
+		p.stats.Failed++
+		<-p.slots
+		return nil, err
+	}
+	p.active[bot.ID] = struct{}{}
+	p.stats.Created++
+
+	return bot, nil
+}
+
+// Release frees the slot held by the bot. Releasing an unknown bot is a no-op.
+func (p *BotPool) Release(botID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.active[botID]; !ok {
+		return
+	}
+	delete(p.active, botID)
+	p.stats.Released++
+	<-p.slots
+}
+
+// Refresh retrieves every active bot and releases the ones in a terminal status, as well as
+// the ones that no longer exist.
+func (p *BotPool) Refresh(ctx context.Context) error {
+	p.mu.Lock()
+	ids := make([]string, 0, len(p.active))
+	for id := range p.active {
+		ids = append(ids, id)
+	}
+	p.mu.Unlock()
+
+	var errs []error
+	for _, id := range ids {
+		bot, err := p.bots.RetrieveBot(ctx, id)
+		if IsNotFound(err) {
+			p.Release(id)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to refresh bot %s: %w", id, err))
+			continue
+		}
+		if bot.CurrentStatus().IsTerminal() {
+			p.Release(id)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run refreshes the pool every poll interval until the context is cancelled,
+// reporting failed refreshes to OnError.
+func (p *BotPool) Run(ctx context.Context) error {
+	ticker := p.opts.Clock.NewTicker(p.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// A failed refresh only holds the slots of finished bots until the next tick.
+		if err := p.Refresh(ctx); err != nil && p.opts.OnError != nil && ctx.Err() == nil {
+			p.opts.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+// Stats returns a snapshot of the pool usage.
+func (p *BotPool) Stats() BotPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.stats
+	stats.Active = len(p.active) + p.creating
+	return stats
+}
@@ -0,0 +1,147 @@
+package recallaigo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestBotPool(t *testing.T) {
+	bots := &fakeBotService{
+		bots: map[string]*recallaigo.Bot{},
+		next: recallaigo.Bot{StatusChanges: []recallaigo.StatusChange{{Code: "joining_call"}}},
+	}
+	request := &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"}
+
+	pool, err := recallaigo.NewBotPool(bots, recallaigo.BotPoolOptions{MaxConcurrent: 1})
+	if err != nil {
+		t.Fatalf("NewBotPool() error = %v", err)
+	}
+
+	bot, err := pool.CreateBot(context.Background(), request)
+	if err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := pool.CreateBot(ctx, request); err == nil {
+		t.Fatal("CreateBot() error = nil, want error when the pool is full")
+	}
+
+	bots.bots[bot.ID].StatusChanges = append(bots.bots[bot.ID].StatusChanges, recallaigo.StatusChange{Code: "done"})
+	if err := pool.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if _, err := pool.CreateBot(context.Background(), request); err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+
+	want := recallaigo.BotPoolStats{Limit: 1, Active: 1, Created: 2, Released: 1}
+	if got := pool.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBotPoolReleasesDeletedBots(t *testing.T) {
+	bots := &fakeBotService{
+		bots: map[string]*recallaigo.Bot{},
+		next: recallaigo.Bot{StatusChanges: []recallaigo.StatusChange{{Code: "joining_call"}}},
+	}
+	pool, err := recallaigo.NewBotPool(bots, recallaigo.BotPoolOptions{MaxConcurrent: 1})
+	if err != nil {
+		t.Fatalf("NewBotPool() error = %v", err)
+	}
+
+	bot, err := pool.CreateBot(context.Background(), &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"})
+	if err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+	delete(bots.bots, bot.ID)
+
+	if err := pool.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	want := recallaigo.BotPoolStats{Limit: 1, Created: 1, Released: 1}
+	if got := pool.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+// blockingBotService creates bots once unblocked.
+type blockingBotService struct {
+	recallaigo.BotService
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (b *blockingBotService) CreateBot(ctx context.Context, request *recallaigo.CreateBotRequest) (*recallaigo.Bot, error) {
+	close(b.started)
+	<-b.unblock
+	return &recallaigo.Bot{ID: "bot_id"}, nil
+}
+
+func TestBotPoolStatsCountCreatesInFlight(t *testing.T) {
+	bots := &blockingBotService{started: make(chan struct{}), unblock: make(chan struct{})}
+	pool, err := recallaigo.NewBotPool(bots, recallaigo.BotPoolOptions{MaxConcurrent: 2})
+	if err != nil {
+		t.Fatalf("NewBotPool() error = %v", err)
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := pool.CreateBot(context.Background(), &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"})
+		done <- err
+	}()
+	<-bots.started
+
+	if got := pool.Stats(); got.Active != 1 {
+		t.Errorf("Stats().Active = %d while creating, want 1", got.Active)
+	}
+
+	close(bots.unblock)
+	if err := <-done; err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+	want := recallaigo.BotPoolStats{Limit: 2, Active: 1, Created: 1}
+	if got := pool.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBotPoolRunReportsErrors(t *testing.T) {
+	bots := unavailableBotService{&fakeBotService{
+		bots: map[string]*recallaigo.Bot{},
+		next: recallaigo.Bot{StatusChanges: []recallaigo.StatusChange{{Code: "joining_call"}}},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errs []error
+	pool, err := recallaigo.NewBotPool(bots, recallaigo.BotPoolOptions{
+		MaxConcurrent: 1,
+		OnError: func(err error) {
+			errs = append(errs, err)
+			cancel()
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewBotPool() error = %v", err)
+	}
+	if _, err := pool.CreateBot(context.Background(), &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"}); err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+
+	if err := pool.Run(ctx); err != context.Canceled {
+		t.Fatalf("Run() error = %v, want %v", err, context.Canceled)
+	}
+	if len(errs) != 1 {
+		t.Errorf("OnError() called with %v, want one error", errs)
+	}
+	if got := pool.Stats(); got.Active != 1 {
+		t.Errorf("Stats().Active = %d, want the slot still held", got.Active)
+	}
+}
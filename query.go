@@ -0,0 +1,54 @@
+package recallaigo
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// query builds the query string of a request. Unlike url.Values it needs no map and keeps the
+// parameters in the order they are added, which keeps the list endpoints cheap to poll.
+// A nil *query has no parameters.
+type query struct {
+	buf []byte
+}
+
+// add appends a parameter; repeated keys are sent as repeated parameters.
+func (q *query) add(key, value string) {
+	if len(q.buf) > 0 {
+		q.buf = append(q.buf, '&')
+	}
+	q.buf = append(q.buf, url.QueryEscape(key)...)
+	q.buf = append(q.buf, '=')
+	q.buf = append(q.buf, url.QueryEscape(value)...)
+}
+
+// addString appends a parameter unless the value is empty.
+func (q *query) addString(key, value string) {
+	if value != "" {
+		q.add(key, value)
+	}
+}
+
+// addInt appends a parameter unless the value is zero.
+func (q *query) addInt(key string, value int) {
+	if value != 0 {
+		q.add(key, "")
+		q.buf = strconv.AppendInt(q.buf, int64(value), 10)
+	}
+}
+
+// addTrue appends the parameter as "true" if the value is true. The API treats absent
+// flags as false.
+func (q *query) addTrue(key string, value bool) {
+	if value {
+		q.add(key, "true")
+	}
+}
+
+// encode returns the query string, without a leading "?".
+func (q *query) encode() string {
+	if q == nil {
+		return ""
+	}
+	return string(q.buf)
+}
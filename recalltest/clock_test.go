@@ -0,0 +1,78 @@
+package recalltest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC)
+	clock := recalltest.NewFakeClock(start)
+
+	ticker := clock.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	clock.Advance(5 * time.Second)
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker fired before its interval")
+	default:
+	}
+
+	clock.Advance(5 * time.Second)
+	select {
+	case tick := <-ticker.C():
+		if want := start.Add(10 * time.Second); !tick.Equal(want) {
+			t.Errorf("tick = %v, want %v", tick, want)
+		}
+	default:
+		t.Fatal("ticker did not fire after its interval")
+	}
+
+	if got := clock.Now(); !got.Equal(start.Add(10 * time.Second)) {
+		t.Errorf("Now() = %v, want %v", got, start.Add(10*time.Second))
+	}
+}
+
+func TestFakeClockWaitForStatus(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+	srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.Response{
+		Body: `{"id": "some_id", "status_changes": [{"code": "joining_call"}]}`,
+	})
+
+	clock := recalltest.NewFakeClock(time.Now())
+	client := srv.NewClient(recallaigo.WithClock(clock))
+
+	type result struct {
+		bot *recallaigo.Bot
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		bot, err := client.Bot.WaitForStatus(context.Background(), "some_id", time.Hour, recallaigo.StatusInCallRecording)
+		done <- result{bot, err}
+	}()
+
+	clock.BlockUntilTickers(1)
+	srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.Response{
+		Body: `{"id": "some_id", "status_changes": [{"code": "in_call_recording"}]}`,
+	})
+	clock.Advance(time.Hour)
+
+	select {
+	case res := <-done:
+		if res.err != nil {
+			t.Fatalf("WaitForStatus() error = %v", res.err)
+		}
+		if res.bot.CurrentStatus() != recallaigo.StatusInCallRecording {
+			t.Errorf("WaitForStatus() status = %s, want %s", res.bot.CurrentStatus(), recallaigo.StatusInCallRecording)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WaitForStatus() did not return after the clock advanced")
+	}
+}
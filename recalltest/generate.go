@@ -0,0 +1,144 @@
+package recalltest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+var (
+	secretFieldPattern = regexp.MustCompile(`"(\w*(?:token|secret|password|signature|api_key)\w*|tk)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	emailPattern       = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	urlPattern         = regexp.MustCompile(`https?://[^\s"\\]+`)
+)
+
+// SanitizeFixture scrubs a JSON response of the API so it can be committed as a fixture:
+//   - string values of secret fields, such as tokens and passwords, become "redacted",
+//   - emails become user@example.com,
+//   - URLs point to the host https://media.test, without their query, e.g. the signature of a download URL.
+//
+// The fields keep their order, and the result is indented with two spaces like the existing fixtures.
+func SanitizeFixture(data []byte) ([]byte, error) {
+	if !json.Valid(data) {
+		return nil, errors.New("invalid JSON")
+	}
+
+	data = secretFieldPattern.ReplaceAll(data, []byte(`"$1"$2"redacted"`))
+	data = emailPattern.ReplaceAll(data, []byte("user@example.com"))
+	data = urlPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		u, err := url.Parse(string(match))
+		if err != nil {
+			return []byte(fixtureMediaHost)
+		}
+		return []byte(fixtureMediaHost + u.EscapedPath())
+	})
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to indent fixture: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// GenerateFixtures fetches the read-only endpoints of a bot from the live API and writes their
+// sanitized responses into dir, named like the fixtures in the test_data directory of recallaigo,
+// e.g. "retrieve_bot.json". opts configure the client, e.g. with recallaigo.WithRegion;
+// a recallaigo.WithHTTPClient option is overridden.
+//
+// The bot should have finished, so that its transcript and media exist.
+func GenerateFixtures(ctx context.Context, token, botID, dir string, opts ...recallaigo.ClientOption) error {
+	recorder := &responseRecorder{transport: http.DefaultTransport}
+	opts = append(opts, recallaigo.WithHTTPClient(&http.Client{Transport: recorder}))
+	client := recallaigo.NewClient(token, opts...)
+
+	calls := []struct {
+		name string
+		call func() error
+	}{
+		{"list_bots.json", func() error {
+			_, err := client.Bot.ListBots(ctx, nil)
+			return err
+		}},
+		{"retrieve_bot.json", func() error {
+			_, err := client.Bot.RetrieveBot(ctx, botID)
+			return err
+		}},
+		{"get_bot_transcript.json", func() error {
+			_, err := client.Bot.GetBotTranscript(ctx, botID)
+			return err
+		}},
+		{"get_speaker_timeline.json", func() error {
+			_, err := client.Bot.GetSpeakerTimeline(ctx, botID)
+			return err
+		}},
+		{"list_chat_messages.json", func() error {
+			_, err := client.Bot.ListChatMessages(ctx, botID)
+			return err
+		}},
+		{"list_bot_screenshots.json", func() error {
+			_, err := client.Bot.ListBotScreenshots(ctx, botID)
+			return err
+		}},
+		{"get_bot_log.json", func() error {
+			_, err := client.Bot.GetBotLogs(ctx, botID)
+			return err
+		}},
+		{"get_bot_intelligence.json", func() error {
+			_, err := client.Bot.GetBotIntelligence(ctx, botID)
+			return err
+		}},
+		{"list_audio_mixed.json", func() error {
+			_, err := client.Media.ListAudioMixed(ctx, nil)
+			return err
+		}},
+	}
+
+	for _, c := range calls {
+		if err := c.call(); err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", c.name, err)
+		}
+
+		data, err := SanitizeFixture(recorder.body)
+		if err != nil {
+			return fmt.Errorf("failed to sanitize %s: %w", c.name, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, c.name), data, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", c.name, err)
+		}
+	}
+	return nil
+}
+
+// responseRecorder is a transport keeping the body of the last API response.
+type responseRecorder struct {
+	transport http.RoundTripper
+	body      []byte
+}
+
+func (r *responseRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.body = body
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package recalltest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+func TestSanitizeFixture(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "redacts secret fields",
+			data: `{"meeting_password":"hunter2","tk":"abc","auth_token":"t0k\"en","code":"done"}`,
+			want: `{"meeting_password":"redacted","tk":"redacted","auth_token":"redacted","code":"done"}`,
+		},
+		{
+			name: "replaces emails",
+			data: `{"email":"jane.doe@acme.io","text":"write to bob@acme.io"}`,
+			want: `{"email":"user@example.com","text":"write to user@example.com"}`,
+		},
+		{
+			name: "points URLs to the media host without query",
+			data: `{"download_url":"https://bucket.s3.amazonaws.com/bots/123/audio.mp3?X-Amz-Signature=abc","next":"https://us-east-1.recall.ai/api/v1/bot/?cursor=xyz"}`,
+			want: `{"download_url":"https://media.test/bots/123/audio.mp3","next":"https://media.test/api/v1/bot/"}`,
+		},
+		{
+			name: "keeps field order and other values",
+			data: `{"z":1,"a":[true,null,"x"]}`,
+			want: `{"z":1,"a":[true,null,"x"]}`,
+		},
+		{
+			name:    "rejects invalid JSON",
+			data:    `{"id":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := recalltest.SanitizeFixture([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SanitizeFixture() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compact := strings.Join(strings.Fields(string(got)), ""); compact != strings.ReplaceAll(tt.want, " ", "") {
+				t.Errorf("SanitizeFixture() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateFixtures(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+
+	dir := t.TempDir()
+	if err := recalltest.GenerateFixtures(context.Background(), "some_token", "some_id", dir, recallaigo.WithBaseURL(srv.URL)); err != nil {
+		t.Fatalf("GenerateFixtures() error = %v", err)
+	}
+
+	for _, name := range []string{"retrieve_bot.json", "get_bot_transcript.json", "list_audio_mixed.json"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("fixture %s not written: %v", name, err)
+		}
+		if strings.Contains(string(data), srv.URL) {
+			t.Errorf("fixture %s contains the server URL", name)
+		}
+	}
+}
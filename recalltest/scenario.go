@@ -0,0 +1,119 @@
+package recalltest
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// Step is a point of a scripted bot lifecycle, reached At after the scenario started.
+type Step struct {
+	At time.Duration
+	// The status the bot changes to. An empty status keeps the current one, e.g. for steps
+	// that only add to the transcript.
+	Status recallaigo.Status
+	// The transcript entries appended when the step is reached.
+	Transcript []recallaigo.TranscriptEntry
+}
+
+// Lifecycle returns the steps of a bot that joins the call, records it for the given duration
+// and finishes, with the entries of the transcript appearing one by one while it records.
+func Lifecycle(recording time.Duration, transcript ...recallaigo.TranscriptEntry) []Step {
+	const joining = 5 * time.Second
+
+	steps := []Step{
+		{At: 0, Status: recallaigo.StatusJoiningCall},
+		{At: joining, Status: recallaigo.StatusInCallRecording},
+	}
+	for i, entry := range transcript {
+		at := joining + recording*time.Duration(i+1)/time.Duration(len(transcript)+1)
+		steps = append(steps, Step{At: at, Transcript: []recallaigo.TranscriptEntry{entry}})
+	}
+	return append(steps,
+		Step{At: joining + recording, Status: recallaigo.StatusCallEnded},
+		Step{At: joining + recording + time.Second, Status: recallaigo.StatusDone},
+	)
+}
+
+// scenario is a scripted bot played back on a clock.
+type scenario struct {
+	clock recallaigo.Clock
+	start time.Time
+	steps []Step
+}
+
+// PlayScenario scripts the bot with the given ID: from now on, RetrieveBot returns the status
+// changes and GetBotTranscript the transcript of the steps reached on the clock, which starts
+// the scenario at its current time. Steps must be sorted by At. With a recalltest.FakeClock,
+// pollers and watchers can be tested end to end by advancing the clock:
+//
+//	clock := recalltest.NewFakeClock(time.Now())
+//	srv.PlayScenario(clock, "bot_id", recalltest.Lifecycle(time.Minute, entries...)...)
+//	client := srv.NewClient(recallaigo.WithClock(clock))
+//
+// A scenario takes precedence over the responses of SetResponse for its bot.
+func (s *Server) PlayScenario(clock recallaigo.Clock, botID string, steps ...Step) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.scenarios == nil {
+		s.scenarios = make(map[string]*scenario)
+	}
+	s.scenarios[botID] = &scenario{clock: clock, start: clock.Now(), steps: steps}
+}
+
+// scenarioResponse returns the response of a scripted bot, if the request is for one.
+func (s *Server) scenarioResponse(route string, r *http.Request) (Response, bool) {
+	s.mu.Lock()
+	sc, ok := s.scenarios[r.PathValue("id")]
+	s.mu.Unlock()
+	if !ok {
+		return Response{}, false
+	}
+
+	changes, transcript := sc.state()
+	switch route {
+	case RouteRetrieveBot:
+		var bot map[string]any
+		if err := json.Unmarshal([]byte(FixtureResponse("bot.json").Body), &bot); err != nil {
+			panic(err)
+		}
+		bot["id"] = r.PathValue("id")
+		bot["status_changes"] = changes
+		return jsonResponse(bot), true
+	case RouteGetBotTranscript:
+		return jsonResponse(transcript), true
+	}
+	return Response{}, false
+}
+
+// state returns the status changes and the transcript of the steps reached so far.
+func (sc *scenario) state() ([]recallaigo.StatusChange, []recallaigo.TranscriptEntry) {
+	elapsed := sc.clock.Now().Sub(sc.start)
+
+	changes := []recallaigo.StatusChange{}
+	transcript := []recallaigo.TranscriptEntry{}
+	for _, step := range sc.steps {
+		if step.At > elapsed {
+			break
+		}
+		if step.Status != "" {
+			changes = append(changes, recallaigo.StatusChange{
+				Code:      step.Status,
+				CreatedAt: recallaigo.Time{Time: sc.start.Add(step.At)},
+			})
+		}
+		transcript = append(transcript, step.Transcript...)
+	}
+	return changes, transcript
+}
+
+func jsonResponse(v any) Response {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return Response{StatusCode: http.StatusOK, Body: string(data)}
+}
@@ -0,0 +1,99 @@
+package recalltest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+func newEntry(speaker, text string) recallaigo.TranscriptEntry {
+	return recallaigo.TranscriptEntry{
+		Speaker: speaker,
+		Words:   []recallaigo.WordDetail{{Text: text}},
+	}
+}
+
+func TestPlayScenario(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+
+	clock := recalltest.NewFakeClock(time.Date(2025, 3, 18, 10, 0, 0, 0, time.UTC))
+	srv.PlayScenario(clock, "scripted", recalltest.Lifecycle(time.Minute, newEntry("Alice", "hello"), newEntry("Bob", "hi"))...)
+	client := srv.NewClient(recallaigo.WithClock(clock))
+	ctx := context.Background()
+
+	tests := []struct {
+		at             time.Duration
+		wantStatus     recallaigo.Status
+		wantTranscript int
+	}{
+		{at: 0, wantStatus: recallaigo.StatusJoiningCall, wantTranscript: 0},
+		{at: 5 * time.Second, wantStatus: recallaigo.StatusInCallRecording, wantTranscript: 0},
+		{at: 25 * time.Second, wantStatus: recallaigo.StatusInCallRecording, wantTranscript: 1},
+		{at: 45 * time.Second, wantStatus: recallaigo.StatusInCallRecording, wantTranscript: 2},
+		{at: 65 * time.Second, wantStatus: recallaigo.StatusCallEnded, wantTranscript: 2},
+		{at: 66 * time.Second, wantStatus: recallaigo.StatusDone, wantTranscript: 2},
+	}
+
+	var elapsed time.Duration
+	for _, tt := range tests {
+		clock.Advance(tt.at - elapsed)
+		elapsed = tt.at
+
+		bot, err := client.Bot.RetrieveBot(ctx, "scripted")
+		if err != nil {
+			t.Fatalf("RetrieveBot() at %v error = %v", tt.at, err)
+		}
+		if bot.ID != "scripted" || bot.CurrentStatus() != tt.wantStatus {
+			t.Errorf("RetrieveBot() at %v = %s with status %s, want scripted with status %s", tt.at, bot.ID, bot.CurrentStatus(), tt.wantStatus)
+		}
+
+		transcript, err := client.Bot.GetBotTranscript(ctx, "scripted")
+		if err != nil {
+			t.Fatalf("GetBotTranscript() at %v error = %v", tt.at, err)
+		}
+		if len(transcript) != tt.wantTranscript {
+			t.Errorf("GetBotTranscript() at %v returned %d entries, want %d", tt.at, len(transcript), tt.wantTranscript)
+		}
+	}
+
+	t.Run("leaves other bots unscripted", func(t *testing.T) {
+		bot, err := client.Bot.RetrieveBot(ctx, "other")
+		if err != nil {
+			t.Fatalf("RetrieveBot() error = %v", err)
+		}
+		if bot.ID == "other" {
+			t.Error("RetrieveBot() of an unscripted bot returned a scripted response")
+		}
+	})
+}
+
+func TestPlayScenarioWaitForStatus(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+
+	clock := recalltest.NewFakeClock(time.Now())
+	srv.PlayScenario(clock, "scripted", recalltest.Lifecycle(time.Minute)...)
+	client := srv.NewClient(recallaigo.WithClock(clock))
+
+	done := make(chan error)
+	go func() {
+		_, err := client.Bot.WaitForStatus(context.Background(), "scripted", 2*time.Minute, recallaigo.StatusDone)
+		done <- err
+	}()
+
+	clock.BlockUntilTickers(1)
+	clock.Advance(2 * time.Minute)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WaitForStatus() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WaitForStatus() did not return after the scenario finished")
+	}
+}
@@ -0,0 +1,226 @@
+// Package recalltest provides a fake Recall.ai API server for testing code that uses recallaigo.
+//
+// The server answers every endpoint of the client with a realistic canned response, which can be
+// replaced per route to simulate other states or errors:
+//
+//	srv := recalltest.NewServer()
+//	defer srv.Close()
+//
+//	srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.ErrorResponse(http.StatusNotFound))
+//	client := srv.NewClient()
+package recalltest
+
+import (
+	"embed"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// fixtureMediaHost is the host of the media URLs in the fixtures, which the server replaces with its own.
+const fixtureMediaHost = "https://media.test"
+
+// MediaContent is the content of every media file served by the server.
+const MediaContent = "recalltest media content"
+
+// Routes of the server, as "METHOD /path" patterns.
+const (
+	RouteListBots                   = "GET /api/v1/bot"
+	RouteCreateBot                  = "POST /api/v1/bot"
+	RouteRetrieveBot                = "GET /api/v1/bot/{id}"
+	RouteUpdateScheduledBot         = "PATCH /api/v1/bot/{id}"
+	RouteDeleteScheduledBot         = "DELETE /api/v1/bot/{id}"
+	RouteDeleteBotMedia             = "POST /api/v1/bot/{id}/delete_media"
+	RouteGetBotIntelligence         = "GET /api/v1/bot/{id}/intelligence"
+	RouteRemoveBotFromCall          = "POST /api/v1/bot/{id}/leave_call"
+	RouteGetBotLogs                 = "GET /api/v1/bot/{id}/logs"
+	RouteOutputAudio                = "POST /api/v1/bot/{id}/output_audio"
+	RouteStopOutputAudio            = "DELETE /api/v1/bot/{id}/output_audio"
+	RouteOutputMedia                = "POST /api/v1/bot/{id}/output_media"
+	RouteStopOutputMedia            = "DELETE /api/v1/bot/{id}/output_media"
+	RouteStartScreenshare           = "POST /api/v1/bot/{id}/output_screenshare"
+	RouteStopScreenshare            = "DELETE /api/v1/bot/{id}/output_screenshare"
+	RouteOutputVideo                = "POST /api/v1/bot/{id}/output_video"
+	RouteStopOutputVideo            = "DELETE /api/v1/bot/{id}/output_video"
+	RoutePauseRecording             = "POST /api/v1/bot/{id}/pause_recording"
+	RouteRequestRecordingPermission = "POST /api/v1/bot/{id}/request_recording_permission"
+	RouteResumeRecording            = "POST /api/v1/bot/{id}/resume_recording"
+	RouteStartRecording             = "POST /api/v1/bot/{id}/start_recording"
+	RouteStopRecording              = "POST /api/v1/bot/{id}/stop_recording"
+	RouteListChatMessages           = "GET /api/v1/bot/{id}/chat-messages"
+	RouteSendChatMessage            = "POST /api/v1/bot/{id}/send_chat_message"
+	RouteGetSpeakerTimeline         = "GET /api/v1/bot/{id}/speaker_timeline"
+	RouteGetBotTranscript           = "GET /api/v1/bot/{id}/transcript"
+	RouteListBotScreenshots         = "GET /api/v1/bot/{id}/screenshots"
+	RouteAnalyzeBotMedia            = "POST /api/v2beta/bot/{id}/analyze"
+	RouteListAudioMixed             = "GET /api/v1/audio_mixed"
+	RouteMedia                      = "GET /media/{file...}"
+)
+
+// defaultFixtures maps each route to the fixture it answers with. Routes without a fixture
+// answer with 204 No Content.
+var defaultFixtures = map[string]string{
+	RouteListBots:                   "list_bots.json",
+	RouteCreateBot:                  "bot.json",
+	RouteRetrieveBot:                "bot.json",
+	RouteUpdateScheduledBot:         "bot.json",
+	RouteDeleteScheduledBot:         "",
+	RouteDeleteBotMedia:             "",
+	RouteGetBotIntelligence:         "intelligence.json",
+	RouteRemoveBotFromCall:          "bot.json",
+	RouteGetBotLogs:                 "logs.json",
+	RouteOutputAudio:                "bot.json",
+	RouteStopOutputAudio:            "bot.json",
+	RouteOutputMedia:                "bot.json",
+	RouteStopOutputMedia:            "bot.json",
+	RouteStartScreenshare:           "bot.json",
+	RouteStopScreenshare:            "bot.json",
+	RouteOutputVideo:                "bot.json",
+	RouteStopOutputVideo:            "bot.json",
+	RoutePauseRecording:             "bot.json",
+	RouteRequestRecordingPermission: "bot.json",
+	RouteResumeRecording:            "bot.json",
+	RouteStartRecording:             "bot.json",
+	RouteStopRecording:              "bot.json",
+	RouteListChatMessages:           "chat_messages.json",
+	RouteSendChatMessage:            "bot.json",
+	RouteGetSpeakerTimeline:         "speaker_timeline.json",
+	RouteGetBotTranscript:           "transcript.json",
+	RouteListBotScreenshots:         "screenshots.json",
+	RouteAnalyzeBotMedia:            "analyze.json",
+	RouteListAudioMixed:             "audio_mixed.json",
+}
+
+// Response is a canned response of the server.
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+// FixtureResponse returns a 200 OK response with the content of a fixture of this package, e.g. "bot.json".
+// It panics if the fixture does not exist.
+func FixtureResponse(name string) Response {
+	data, err := fixtures.ReadFile("fixtures/" + name)
+	if err != nil {
+		panic(err)
+	}
+	return Response{StatusCode: http.StatusOK, Body: string(data)}
+}
+
+// ErrorResponse returns an API error response with the given status code.
+func ErrorResponse(statusCode int) Response {
+	res := FixtureResponse("error.json")
+	res.StatusCode = statusCode
+	return res
+}
+
+// Request is a request received by the server.
+type Request struct {
+	// The route the request matched.
+	Route  string
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Recall.ai API server. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	responses map[string]Response
+	requests  []Request
+	scenarios map[string]*scenario
+}
+
+// NewServer starts a server answering every route with its default fixture.
+// The caller must call Close when finished.
+func NewServer() *Server {
+	s := &Server{responses: make(map[string]Response)}
+
+	mux := http.NewServeMux()
+	for route := range defaultFixtures {
+		mux.HandleFunc(route, s.handler(route))
+	}
+	mux.HandleFunc(RouteMedia, s.handler(RouteMedia))
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// NewClient returns a client sending its requests to the server.
+func (s *Server) NewClient(opts ...recallaigo.ClientOption) *recallaigo.Client {
+	opts = append([]recallaigo.ClientOption{recallaigo.WithBaseURL(s.URL)}, opts...)
+	return recallaigo.NewClient("recalltest_token", opts...)
+}
+
+// SetResponse replaces the response of a route, e.g. RouteRetrieveBot.
+func (s *Server) SetResponse(route string, res Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responses[route] = res
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) handler(route string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Route:  route,
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+		res, ok := s.responses[route]
+		s.mu.Unlock()
+
+		if scripted, isScripted := s.scenarioResponse(route, r); isScripted {
+			res = scripted
+		} else if !ok {
+			res = s.defaultResponse(route)
+		}
+		if res.StatusCode == 0 {
+			res.StatusCode = http.StatusOK
+		}
+
+		if res.Body != "" && route != RouteMedia {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(res.StatusCode)
+		io.Copy(w, strings.NewReader(strings.ReplaceAll(res.Body, fixtureMediaHost, s.URL+"/media")))
+	}
+}
+
+func (s *Server) defaultResponse(route string) Response {
+	if route == RouteMedia {
+		return Response{StatusCode: http.StatusOK, Body: MediaContent}
+	}
+
+	name := defaultFixtures[route]
+	if name == "" {
+		return Response{StatusCode: http.StatusNoContent}
+	}
+	return FixtureResponse(name)
+}
@@ -0,0 +1,90 @@
+package recalltest_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+)
+
+func TestServer(t *testing.T) {
+	srv := recalltest.NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	t.Run("answers with fixtures", func(t *testing.T) {
+		bots, err := client.Bot.ListBots(ctx, nil)
+		if err != nil {
+			t.Fatalf("ListBots() error = %v", err)
+		}
+		if len(bots.Results) == 0 {
+			t.Error("ListBots() returned no bots")
+		}
+
+		bot, err := client.Bot.RetrieveBot(ctx, "some_id")
+		if err != nil {
+			t.Fatalf("RetrieveBot() error = %v", err)
+		}
+		if bot.ID == "" {
+			t.Error("RetrieveBot() returned a bot without ID")
+		}
+
+		entries, err := client.Bot.GetBotTranscript(ctx, "some_id")
+		if err != nil {
+			t.Fatalf("GetBotTranscript() error = %v", err)
+		}
+		if len(entries) == 0 {
+			t.Error("GetBotTranscript() returned no entries")
+		}
+
+		if err := client.Bot.DeleteScheduledBot(ctx, "some_id"); err != nil {
+			t.Errorf("DeleteScheduledBot() error = %v", err)
+		}
+	})
+
+	t.Run("serves media", func(t *testing.T) {
+		audio, err := client.Media.ListAudioMixed(ctx, nil)
+		if err != nil {
+			t.Fatalf("ListAudioMixed() error = %v", err)
+		}
+
+		var buf bytes.Buffer
+		if _, err := client.Media.Download(ctx, audio.Results[0].Data.DownloadURL, &buf); err != nil {
+			t.Fatalf("Download() error = %v", err)
+		}
+		if buf.String() != recalltest.MediaContent {
+			t.Errorf("Download() = %q, want %q", buf.String(), recalltest.MediaContent)
+		}
+	})
+
+	t.Run("replaces responses", func(t *testing.T) {
+		srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.ErrorResponse(http.StatusNotFound))
+		defer srv.SetResponse(recalltest.RouteRetrieveBot, recalltest.FixtureResponse("bot.json"))
+
+		if _, err := client.Bot.RetrieveBot(ctx, "some_id"); err == nil {
+			t.Error("RetrieveBot() error = nil, want error")
+		}
+	})
+
+	t.Run("records requests", func(t *testing.T) {
+		if _, err := client.Bot.CreateBot(ctx, &recallaigo.CreateBotRequest{MeetingURL: "https://zoom.us/j/123", BotName: "Test Bot"}); err != nil {
+			t.Fatalf("CreateBot() error = %v", err)
+		}
+
+		requests := srv.Requests()
+		last := requests[len(requests)-1]
+		if last.Route != recalltest.RouteCreateBot {
+			t.Errorf("Requests() route = %q, want %q", last.Route, recalltest.RouteCreateBot)
+		}
+		if !bytes.Contains(last.Body, []byte(`"bot_name":"Test Bot"`)) {
+			t.Errorf("Requests() body = %s, want the bot name", last.Body)
+		}
+		if last.Header.Get("Authorization") != "Token recalltest_token" {
+			t.Errorf("Requests() Authorization = %q", last.Header.Get("Authorization"))
+		}
+	})
+}
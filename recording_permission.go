@@ -0,0 +1,109 @@
+package recallaigo
+
+import (
+	"context"
+	"time"
+)
+
+// RecordingPermissionOutcome is how a request for recording permission ended.
+type RecordingPermissionOutcome string
+
+const (
+	// The host allowed the bot to record.
+	RecordingPermissionAllowed RecordingPermissionOutcome = "allowed"
+	// The host denied the bot permission to record.
+	RecordingPermissionDenied RecordingPermissionOutcome = "denied"
+	// The bot left the call before the host answered.
+	RecordingPermissionCallEnded RecordingPermissionOutcome = "call_ended"
+	// The host did not answer within the timeout.
+	RecordingPermissionTimedOut RecordingPermissionOutcome = "timed_out"
+)
+
+// RecordingPermissionResult is the result of RequestRecordingPermissionAndWait.
+type RecordingPermissionResult struct {
+	Outcome RecordingPermissionOutcome
+	// The status change deciding the outcome, e.g. with the sub code of a denial. Nil if it timed out.
+	Change *StatusChange
+}
+
+// RecordingPermissionOptions configures the RequestRecordingPermissionAndWait method.
+type RecordingPermissionOptions struct {
+	// How often the bot is polled for the answer of the host. Defaults to 10 seconds.
+	Interval time.Duration
+	// How long the host has to answer. Defaults to 5 minutes.
+	Timeout time.Duration
+	// Checks the bot immediately whenever a value is received, e.g. from a webhook handler
+	// receiving bot.recording_permission_allowed or bot.recording_permission_denied events.
+	// Polling continues as a fallback.
+	Signal <-chan struct{}
+}
+
+// RequestRecordingPermissionAndWait requests recording permission from the host and waits for
+// the answer. The bot starting to record counts as allowed. A host not answering in time is
+// reported as RecordingPermissionTimedOut rather than as an error.
+// This is applicable for Zoom only.
+func (c *BotClient) RequestRecordingPermissionAndWait(ctx context.Context, botID string, opts ...RecordingPermissionOptions) (*RecordingPermissionResult, error) {
+	var opt RecordingPermissionOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Interval <= 0 {
+		opt.Interval = defaultPollInterval
+	}
+	if opt.Timeout <= 0 {
+		opt.Timeout = 5 * time.Minute
+	}
+
+	// Earlier requests also left status changes, so only newer ones count.
+	bot, err := c.RetrieveBot(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	seen := len(bot.StatusChanges)
+
+	if _, err := c.RequestRecordingPermission(ctx, botID); err != nil {
+		return nil, err
+	}
+	deadline := c.client.clock.Now().Add(opt.Timeout)
+
+	ticker := c.client.clock.NewTicker(opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C():
+		case <-opt.Signal:
+		}
+
+		bot, err := c.RetrieveBot(ctx, botID)
+		if err != nil {
+			return nil, err
+		}
+		if result := recordingPermissionResult(bot.StatusChanges[min(seen, len(bot.StatusChanges)):]); result != nil {
+			return result, nil
+		}
+
+		if !c.client.clock.Now().Before(deadline) {
+			return &RecordingPermissionResult{Outcome: RecordingPermissionTimedOut}, nil
+		}
+	}
+}
+
+// recordingPermissionResult returns the outcome decided by the first of the changes answering
+// a request for recording permission, or nil if none does.
+func recordingPermissionResult(changes []StatusChange) *RecordingPermissionResult {
+	for i := range changes {
+		change := &changes[i]
+		switch {
+		case change.Code == StatusRecordingPermissionAllowed || change.Code == StatusInCallRecording:
+			return &RecordingPermissionResult{Outcome: RecordingPermissionAllowed, Change: change}
+		case change.Code == StatusRecordingPermissionDenied:
+			return &RecordingPermissionResult{Outcome: RecordingPermissionDenied, Change: change}
+		case change.Code == StatusCallEnded || change.Code.IsTerminal():
+			return &RecordingPermissionResult{Outcome: RecordingPermissionCallEnded, Change: change}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestRequestRecordingPermissionAndWait(t *testing.T) {
+	const (
+		notRecording = `{"code": "in_call_not_recording"}`
+		allowed      = `{"code": "recording_permission_allowed"}`
+		denied       = `{"code": "recording_permission_denied", "sub_code": "zoom_local_recording_disabled"}`
+		recording    = `{"code": "in_call_recording"}`
+		callEnded    = `{"code": "call_ended", "sub_code": "bot_kicked_from_call"}`
+	)
+
+	tests := []struct {
+		name string
+		// The status changes returned by each retrieval of the bot, the last one repeating.
+		statuses    [][]string
+		wantOutcome recallaigo.RecordingPermissionOutcome
+		wantSubCode recallaigo.SubCode
+	}{
+		{
+			name: "allowed",
+			statuses: [][]string{
+				{notRecording},
+				{notRecording},
+				{notRecording, allowed, recording},
+			},
+			wantOutcome: recallaigo.RecordingPermissionAllowed,
+		},
+		{
+			name: "recording counts as allowed",
+			statuses: [][]string{
+				{notRecording},
+				{notRecording, recording},
+			},
+			wantOutcome: recallaigo.RecordingPermissionAllowed,
+		},
+		{
+			name: "denied after an earlier denial",
+			statuses: [][]string{
+				{notRecording, denied},
+				{notRecording, denied},
+				{notRecording, denied, denied},
+			},
+			wantOutcome: recallaigo.RecordingPermissionDenied,
+			wantSubCode: recallaigo.SubCodeZoomLocalRecordingDisabled,
+		},
+		{
+			name: "call ended",
+			statuses: [][]string{
+				{notRecording},
+				{notRecording, callEnded},
+			},
+			wantOutcome: recallaigo.RecordingPermissionCallEnded,
+			wantSubCode: recallaigo.SubCodeBotKickedFromCall,
+		},
+		{
+			name: "timed out",
+			statuses: [][]string{
+				{notRecording, denied},
+			},
+			wantOutcome: recallaigo.RecordingPermissionTimedOut,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				retrievals int
+				requested  bool
+			)
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				if strings.HasSuffix(req.URL.Path, "/request_recording_permission") {
+					requested = true
+					return testutil.NewStringResponse(`{"id": "some_id"}`, http.StatusOK)
+				}
+
+				statuses := tt.statuses[min(retrievals, len(tt.statuses)-1)]
+				retrievals++
+				return testutil.NewStringResponse(`{"id": "some_id", "status_changes": [`+strings.Join(statuses, ",")+`]}`, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			opts := recallaigo.RecordingPermissionOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}
+			result, err := client.Bot.RequestRecordingPermissionAndWait(context.Background(), "some_id", opts)
+			if err != nil {
+				t.Fatalf("RequestRecordingPermissionAndWait() error = %v", err)
+			}
+			if !requested {
+				t.Error("RequestRecordingPermissionAndWait() did not request permission")
+			}
+			if result.Outcome != tt.wantOutcome {
+				t.Errorf("RequestRecordingPermissionAndWait() outcome = %q, want %q", result.Outcome, tt.wantOutcome)
+			}
+
+			var subCode recallaigo.SubCode
+			if result.Change != nil {
+				subCode = result.Change.SubCode
+			}
+			if subCode != tt.wantSubCode {
+				t.Errorf("RequestRecordingPermissionAndWait() sub code = %q, want %q", subCode, tt.wantSubCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Recurrence describes the occurrences of a recurring meeting.
+type Recurrence struct {
+	// The start of the first occurrence. Its location should be the time zone of the meeting,
+	// so that the occurrences keep their local time across daylight saving time changes.
+	Start time.Time
+	// The number of days between occurrences, e.g. 7 for a weekly meeting.
+	IntervalDays int
+	// The number of occurrences. Zero means no limit other than Until.
+	Count int
+	// The time after which no occurrence starts. Zero means no limit other than Count.
+	Until time.Time
+}
+
+// Occurrences returns the start times of the occurrences. Count or Until must be set.
+func (r Recurrence) Occurrences() ([]time.Time, error) {
+	if r.Start.IsZero() {
+		return nil, errors.New("recurrence start is required")
+	}
+	if r.IntervalDays <= 0 {
+		return nil, errors.New("recurrence interval must be positive")
+	}
+	if r.Count <= 0 && r.Until.IsZero() {
+		return nil, errors.New("recurrence count or until is required")
+	}
+
+	var occurrences []time.Time
+	for i := 0; r.Count <= 0 || i < r.Count; i++ {
+		start := r.Start.AddDate(0, 0, i*r.IntervalDays)
+		if !r.Until.IsZero() && start.After(r.Until) {
+			break
+		}
+		occurrences = append(occurrences, start)
+	}
+	return occurrences, nil
+}
+
+// ScheduleRecurringBots schedules a bot configured like the template for every future occurrence
+// of the meeting of the template, e.g. the result of Recurrence.Occurrences. Occurrences for which
+// a scheduled bot of the same meeting already joins at the same time are skipped, so the call can be
+// repeated safely. The created bots are returned, together with the bots created before an error.
+func ScheduleRecurringBots(ctx context.Context, bots BotService, template *CreateBotRequest, occurrences []time.Time, opts ...ScheduleOptions) ([]Bot, error) {
+	var opt ScheduleOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Clock == nil {
+		opt.Clock = SystemClock{}
+	}
+	if template == nil || template.MeetingURL == "" {
+		return nil, errors.New("meeting URL is required")
+	}
+
+	scheduled, err := scheduledJoins(ctx, bots, template.MeetingURL)
+	if err != nil {
+		return nil, err
+	}
+
+	now := opt.Clock.Now()
+	var created []Bot
+	for _, occurrence := range occurrences {
+		joinAt := occurrence.Add(-opt.JoinEarly)
+		if !joinAt.After(now) || scheduled[joinAt.Unix()] {
+			continue
+		}
+
+		request := *template
+		request.JoinAt = ScheduleAt(joinAt)
+		bot, err := bots.CreateBot(ctx, &request)
+		if err != nil {
+			return created, fmt.Errorf("failed to schedule bot at %s: %w", joinAt.Format(time.RFC3339), err)
+		}
+		created = append(created, *bot)
+		scheduled[joinAt.Unix()] = true
+	}
+	return created, nil
+}
+
+// scheduledJoins returns the join times, in Unix seconds, of the scheduled bots of the meeting.
+func scheduledJoins(ctx context.Context, bots BotService, meetingURL string) (map[int64]bool, error) {
+	joins := make(map[int64]bool)
+	err := forEachBot(ctx, bots, ListBotsParams{MeetingURL: meetingURL}, func(_ int, bot *Bot) error {
+		if bot.JoinAt != nil && sameMeeting(bot.MeetingURL, meetingURL) {
+			joins[bot.JoinAt.Unix()] = true
+		}
+		return nil
+	})
+	return joins, err
+}
+
+// sameMeeting reports whether the meeting URL of a bot is the given meeting, comparing the
+// platform and meeting ID where they are known and the URLs otherwise.
+func sameMeeting(m MeetingURL, meetingURL string) bool {
+	other := ParseMeetingURL(meetingURL)
+	if m.MeetingID != "" && other.MeetingID != "" {
+		return m.Platform == other.Platform && m.MeetingID == other.MeetingID
+	}
+	return m.String() == meetingURL
+}
@@ -0,0 +1,123 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 3, 24, 10, 0, 0, 0, berlin)
+
+	tests := []struct {
+		name       string
+		recurrence recallaigo.Recurrence
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:       "weekly across daylight saving time",
+			recurrence: recallaigo.Recurrence{Start: start, IntervalDays: 7, Count: 3},
+			want:       []string{"2025-03-24T09:00:00Z", "2025-03-31T08:00:00Z", "2025-04-07T08:00:00Z"},
+		},
+		{
+			name:       "until",
+			recurrence: recallaigo.Recurrence{Start: start, IntervalDays: 1, Until: start.AddDate(0, 0, 2)},
+			want:       []string{"2025-03-24T09:00:00Z", "2025-03-25T09:00:00Z", "2025-03-26T09:00:00Z"},
+		},
+		{
+			name:       "unbounded",
+			recurrence: recallaigo.Recurrence{Start: start, IntervalDays: 7},
+			wantErr:    true,
+		},
+		{
+			name:       "no interval",
+			recurrence: recallaigo.Recurrence{Start: start, Count: 2},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrences, err := tt.recurrence.Occurrences()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Occurrences() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, occurrence := range occurrences {
+				got = append(got, occurrence.UTC().Format(time.RFC3339))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleRecurringBots(t *testing.T) {
+	now := time.Date(2025, 3, 18, 12, 0, 0, 0, time.UTC)
+	occurrences := []time.Time{
+		now.Add(-time.Hour),
+		now.Add(24 * time.Hour),
+		now.Add(48 * time.Hour),
+		now.Add(72 * time.Hour),
+		now.Add(72 * time.Hour),
+	}
+
+	var (
+		listQuery string
+		joins     []string
+	)
+	router := testutil.NewRouter()
+	router.Handle("GET /api/v1/bot", func(req *http.Request) *http.Response {
+		listQuery = req.URL.RawQuery
+		// A bot of the same meeting already joins at the second occurrence, one of another meeting at the third.
+		return testutil.NewStringResponse(`{"next": null, "results": [
+			{"id": "existing", "meeting_url": {"meeting_id": "123", "platform": "zoom"}, "join_at": "2025-03-20T12:00:00Z"},
+			{"id": "other", "meeting_url": {"meeting_id": "456", "platform": "zoom"}, "join_at": "2025-03-19T12:00:00Z"}
+		]}`, http.StatusOK)
+	})
+	router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+		var body struct {
+			JoinAt string `json:"join_at"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		joins = append(joins, body.JoinAt)
+		return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+	template := &recallaigo.CreateBotRequest{MeetingURL: "https://zoom.us/j/123?pwd=456", BotName: "Notetaker"}
+	created, err := recallaigo.ScheduleRecurringBots(context.Background(), client.Bot, template, occurrences,
+		recallaigo.ScheduleOptions{Clock: recalltest.NewFakeClock(now)})
+	if err != nil {
+		t.Fatalf("ScheduleRecurringBots() error = %v", err)
+	}
+
+	if want := []string{"2025-03-19T12:00:00Z", "2025-03-21T12:00:00Z"}; !slices.Equal(joins, want) {
+		t.Errorf("ScheduleRecurringBots() scheduled bots at %v, want %v", joins, want)
+	}
+	if len(created) != 2 {
+		t.Errorf("ScheduleRecurringBots() returned %d bots, want 2", len(created))
+	}
+	if want := "meeting_url=https%3A%2F%2Fzoom.us%2Fj%2F123%3Fpwd%3D456&page=1"; listQuery != want {
+		t.Errorf("ScheduleRecurringBots() listed bots with %q, want %q", listQuery, want)
+	}
+	if template.JoinAt != nil {
+		t.Error("ScheduleRecurringBots() modified the template")
+	}
+}
@@ -0,0 +1,177 @@
+package recallaigo
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Kinds of personally identifiable information found by the default detectors.
+const (
+	PIIKindEmail      = "email"
+	PIIKindPhone      = "phone"
+	PIIKindCreditCard = "credit_card"
+)
+
+// PIIMatch is a span of sensitive text found by a PIIDetector.
+type PIIMatch struct {
+	// The byte offsets of the match in the text.
+	Start, End int
+	Kind       string
+}
+
+// PIIDetector finds sensitive text, e.g. with a regular expression or an entity recognition service.
+type PIIDetector interface {
+	Detect(text string) []PIIMatch
+}
+
+// RegexpDetector is a PIIDetector reporting every match of the pattern as the given kind.
+type RegexpDetector struct {
+	Kind    string
+	Pattern *regexp.Regexp
+}
+
+func (d RegexpDetector) Detect(text string) []PIIMatch {
+	var matches []PIIMatch
+	for _, loc := range d.Pattern.FindAllStringIndex(text, -1) {
+		matches = append(matches, PIIMatch{Start: loc[0], End: loc[1], Kind: d.Kind})
+	}
+	return matches
+}
+
+// DefaultPIIDetectors returns detectors for emails, phone numbers and credit-card-like numbers.
+func DefaultPIIDetectors() []PIIDetector {
+	return []PIIDetector{
+		RegexpDetector{Kind: PIIKindEmail, Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+		RegexpDetector{Kind: PIIKindCreditCard, Pattern: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)},
+		RegexpDetector{Kind: PIIKindPhone, Pattern: regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{3}\)|\b\d{3})[ .-]?\d{3}[ .-]?\d{4}\b`)},
+	}
+}
+
+// RedactorOptions configures a Redactor.
+type RedactorOptions struct {
+	// The detectors to run. Defaults to DefaultPIIDetectors.
+	Detectors []PIIDetector
+	// Returns the text replacing a match of the given kind. Defaults to the upper-cased kind in brackets, e.g. "[EMAIL]".
+	Replacement func(kind string) string
+}
+
+// Redactor masks sensitive text in transcripts and chat messages.
+type Redactor struct {
+	detectors   []PIIDetector
+	replacement func(kind string) string
+}
+
+// NewRedactor creates a new Redactor.
+func NewRedactor(opts RedactorOptions) *Redactor {
+	if opts.Detectors == nil {
+		opts.Detectors = DefaultPIIDetectors()
+	}
+	if opts.Replacement == nil {
+		opts.Replacement = func(kind string) string {
+			return "[" + strings.ToUpper(kind) + "]"
+		}
+	}
+
+	return &Redactor{
+		detectors:   opts.Detectors,
+		replacement: opts.Replacement,
+	}
+}
+
+// RedactText returns the text with every detected match replaced.
+func (r *Redactor) RedactText(text string) string {
+	var (
+		b    strings.Builder
+		last int
+	)
+	for _, match := range r.detect(text) {
+		b.WriteString(text[last:match.Start])
+		b.WriteString(r.replacement(match.Kind))
+		last = match.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// RedactTranscript returns a copy of the transcript with detected matches replaced.
+// Matches may span several words, e.g. a phone number spoken in groups; these words are
+// replaced by a single word covering their timing. The Raw field of the entries is cleared.
+func (r *Redactor) RedactTranscript(entries []TranscriptEntry) []TranscriptEntry {
+	redacted := make([]TranscriptEntry, len(entries))
+	for i, entry := range entries {
+		entry.Raw = nil
+		entry.Words = r.redactWords(entry.Words)
+		redacted[i] = entry
+	}
+	return redacted
+}
+
+// RedactMessages returns a copy of the chat messages with detected matches in their text replaced.
+func (r *Redactor) RedactMessages(messages []Message) []Message {
+	redacted := make([]Message, len(messages))
+	for i, message := range messages {
+		message.Text = r.RedactText(message.Text)
+		redacted[i] = message
+	}
+	return redacted
+}
+
+func (r *Redactor) redactWords(words []WordDetail) []WordDetail {
+	// Detect on the joined text so matches spanning several words are found.
+	var text strings.Builder
+	offsets := make([]int, len(words))
+	for i, word := range words {
+		if i > 0 {
+			text.WriteByte(' ')
+		}
+		offsets[i] = text.Len()
+		text.WriteString(word.Text)
+	}
+
+	matches := r.detect(text.String())
+	if len(matches) == 0 {
+		return slices.Clone(words)
+	}
+
+	var redacted []WordDetail
+	for i := 0; i < len(words); i++ {
+		start, end := offsets[i], offsets[i]+len(words[i].Text)
+		j := slices.IndexFunc(matches, func(m PIIMatch) bool {
+			return m.Start < end && start < m.End
+		})
+		if j < 0 {
+			redacted = append(redacted, words[i])
+			continue
+		}
+
+		word := words[i]
+		word.Text = r.replacement(matches[j].Kind)
+		for i+1 < len(words) && offsets[i+1] < matches[j].End {
+			i++
+			word.EndTimestamp = words[i].EndTimestamp
+		}
+		redacted = append(redacted, word)
+	}
+	return redacted
+}
+
+// detect runs every detector and returns the matches sorted by offset, dropping overlaps.
+func (r *Redactor) detect(text string) []PIIMatch {
+	var matches []PIIMatch
+	for _, detector := range r.detectors {
+		matches = append(matches, detector.Detect(text)...)
+	}
+	slices.SortStableFunc(matches, func(a, b PIIMatch) int {
+		return a.Start - b.Start
+	})
+
+	var result []PIIMatch
+	for _, match := range matches {
+		if n := len(result); n > 0 && match.Start < result[n-1].End {
+			continue
+		}
+		result = append(result, match)
+	}
+	return result
+}
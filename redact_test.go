@@ -0,0 +1,86 @@
+package recallaigo_test
+
+import (
+	"regexp"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestRedactText(t *testing.T) {
+	tests := []struct {
+		name string
+		opts recallaigo.RedactorOptions
+		text string
+		want string
+	}{
+		{
+			name: "masks email",
+			text: "Mail me at jane.doe@example.com please",
+			want: "Mail me at [EMAIL] please",
+		},
+		{
+			name: "masks phone numbers",
+			text: "Call +1 (555) 123-4567 or 555.987.6543",
+			want: "Call [PHONE] or [PHONE]",
+		},
+		{
+			name: "masks credit card numbers",
+			text: "Card 4111 1111 1111 1111 expires soon",
+			want: "Card [CREDIT_CARD] expires soon",
+		},
+		{
+			name: "keeps short numbers",
+			text: "We have 3 items for 2024",
+			want: "We have 3 items for 2024",
+		},
+		{
+			name: "uses custom detectors and replacement",
+			opts: recallaigo.RedactorOptions{
+				Detectors:   []recallaigo.PIIDetector{recallaigo.RegexpDetector{Kind: "ssn", Pattern: regexp.MustCompile(`\d{3}-\d{2}-\d{4}`)}},
+				Replacement: func(string) string { return "***" },
+			},
+			text: "SSN 123-45-6789",
+			want: "SSN ***",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := recallaigo.NewRedactor(tt.opts).RedactText(tt.text)
+			if got != tt.want {
+				t.Errorf("RedactText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactTranscript(t *testing.T) {
+	entry := newTranscriptEntry("Alice", 1, 0, "my number is 555 123 4567 thanks")
+	entry.Raw = []byte(`{"text": "my number is 555 123 4567 thanks"}`)
+
+	got := recallaigo.NewRedactor(recallaigo.RedactorOptions{}).RedactTranscript([]recallaigo.TranscriptEntry{entry})
+
+	utterances := recallaigo.Utterances(got)
+	if utterances[0].Text != "my number is [PHONE] thanks" {
+		t.Errorf("RedactTranscript() text = %q, want %q", utterances[0].Text, "my number is [PHONE] thanks")
+	}
+	if phone := got[0].Words[3]; phone.StartTimestamp != 3 || phone.EndTimestamp != 6 {
+		t.Errorf("RedactTranscript() phone word = %+v, want it to cover 3s to 6s", phone)
+	}
+	if got[0].Raw != nil {
+		t.Error("RedactTranscript() kept the raw entry")
+	}
+	if entry.Words[3].Text != "555" {
+		t.Error("RedactTranscript() modified the input transcript")
+	}
+}
+
+func TestRedactMessages(t *testing.T) {
+	messages := []recallaigo.Message{{Text: "reach me at bob@example.com"}}
+
+	got := recallaigo.NewRedactor(recallaigo.RedactorOptions{}).RedactMessages(messages)
+	if got[0].Text != "reach me at [EMAIL]" {
+		t.Errorf("RedactMessages() = %q, want %q", got[0].Text, "reach me at [EMAIL]")
+	}
+}
@@ -0,0 +1,72 @@
+package recallaigo
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// RegionMismatchError is returned for requests about a bot of another region than the one of the client.
+type RegionMismatchError struct {
+	BotID        string
+	BotRegion    Region
+	ClientRegion Region
+}
+
+func (e *RegionMismatchError) Error() string {
+	return fmt.Sprintf("bot %s belongs to region %s, but the client uses region %s", e.BotID, e.BotRegion, e.ClientRegion)
+}
+
+// WithRegionGuard records the region of the bots created, retrieved or listed by the client in
+// the store, and fails requests about a bot of another region with a *RegionMismatchError before
+// they are sent, instead of the 404 Not Found of the API. The clients of every region should
+// share the store, e.g. a MemoryCache, to learn each other's bots; bots never seen by any of them
+// are requested as usual.
+func WithRegionGuard(store CacheStore) ClientOption {
+	return func(c *Client) {
+		c.regions = store
+	}
+}
+
+// regionKey returns the key of the region of a bot. Unlike cacheKey, it does not depend on the
+// API host, so that the clients of all regions find it.
+func (c *Client) regionKey(botID string) string {
+	account := sha256.Sum256([]byte(c.Token))
+	return fmt.Sprintf("recallai:%x:region:%s", account[:8], botID)
+}
+
+// rememberRegion records the region of the bot if the region guard is enabled.
+func (c *Client) rememberRegion(bot *Bot) {
+	if c.regions != nil && bot.ID != "" {
+		c.regions.Set(c.regionKey(bot.ID), []byte(c.Region))
+	}
+}
+
+// checkRegion returns a *RegionMismatchError if the API path is about a bot of another region.
+// path is relative to the base URL, e.g. "api/v1/bot/{id}/transcript".
+func (c *Client) checkRegion(path string) error {
+	if c.regions == nil {
+		return nil
+	}
+
+	botID := botIDFromPath(path)
+	if botID == "" {
+		return nil
+	}
+
+	region, ok := c.regions.Get(c.regionKey(botID))
+	if !ok || Region(region) == c.Region {
+		return nil
+	}
+	return &RegionMismatchError{BotID: botID, BotRegion: Region(region), ClientRegion: c.Region}
+}
+
+// botIDFromPath returns the ID of the bot an API path is about, e.g. "api/v1/bot/{id}/transcript",
+// or "" if it is about no bot.
+func botIDFromPath(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) < 4 || segments[0] != "api" || segments[2] != "bot" {
+		return ""
+	}
+	return segments[3]
+}
@@ -0,0 +1,125 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestRegionGuard(t *testing.T) {
+	const botID = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+
+	var requests []string
+	router := testutil.NewRouter()
+	router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+		requests = append(requests, req.Host+" "+req.URL.Path)
+		return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+	})
+	router.Handle("GET /api/v1/bot/{id}", func(req *http.Request) *http.Response {
+		requests = append(requests, req.Host+" "+req.URL.Path)
+		return testutil.NewFileResponse(t, "test_data/retrieve_bot.json", http.StatusOK)
+	})
+
+	store := recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{})
+	newClient := func(region recallaigo.Region, opts ...recallaigo.ClientOption) *recallaigo.Client {
+		opts = append([]recallaigo.ClientOption{
+			recallaigo.WithHTTPClient(router.Client()),
+			recallaigo.WithRegion(region),
+			recallaigo.WithRegionGuard(store),
+		}, opts...)
+		return recallaigo.NewClient("some_token", opts...)
+	}
+	east, west := newClient(recallaigo.UsEast), newClient(recallaigo.UsWest)
+	ctx := context.Background()
+
+	// Bots never seen are requested as usual.
+	if _, err := west.Bot.RetrieveBot(ctx, "unknown_bot"); err != nil {
+		t.Fatalf("RetrieveBot() error = %v", err)
+	}
+
+	bot, err := east.Bot.CreateBot(ctx, &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"})
+	if err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+	if bot.ID != botID {
+		t.Fatalf("CreateBot() ID = %q, want %q", bot.ID, botID)
+	}
+	if _, err := east.Bot.RetrieveBot(ctx, botID); err != nil {
+		t.Errorf("RetrieveBot() in the region of the bot error = %v", err)
+	}
+
+	requests = nil
+	_, err = west.Bot.RetrieveBot(ctx, botID)
+	var mismatch *recallaigo.RegionMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("RetrieveBot() in another region error = %v, want a *RegionMismatchError", err)
+	}
+	want := recallaigo.RegionMismatchError{BotID: botID, BotRegion: recallaigo.UsEast, ClientRegion: recallaigo.UsWest}
+	if *mismatch != want {
+		t.Errorf("error = %+v, want %+v", *mismatch, want)
+	}
+	if len(requests) != 0 {
+		t.Errorf("sent %v, want no request", requests)
+	}
+
+	// The guard also covers requests built with NewRequest, with base URLs with a path.
+	proxied := newClient(recallaigo.UsWest, recallaigo.WithBaseURL("https://proxy.test/recall/"))
+	req, err := proxied.NewRequest(ctx, http.MethodGet, "bot/"+botID+"/new_endpoint")
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	if _, err := proxied.Do(req, nil); !errors.As(err, &mismatch) {
+		t.Errorf("Do() error = %v, want a *RegionMismatchError", err)
+	}
+
+	// Clients of other accounts do not share the records.
+	other := recallaigo.NewClient("other_token", recallaigo.WithHTTPClient(router.Client()),
+		recallaigo.WithRegion(recallaigo.UsWest), recallaigo.WithRegionGuard(store))
+	if _, err := other.Bot.RetrieveBot(ctx, botID); err != nil {
+		t.Errorf("RetrieveBot() of another account error = %v", err)
+	}
+}
+
+func TestRegionGuardClosesStreamedBody(t *testing.T) {
+	router := testutil.NewRouter()
+	router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+		return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+	})
+
+	store := recallaigo.NewMemoryCache(recallaigo.MemoryCacheOptions{})
+	east := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()),
+		recallaigo.WithRegion(recallaigo.UsEast), recallaigo.WithRegionGuard(store))
+	west := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()),
+		recallaigo.WithRegion(recallaigo.UsWest), recallaigo.WithRegionGuard(store))
+
+	ctx := context.Background()
+	bot, err := east.Bot.CreateBot(ctx, &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"})
+	if err != nil {
+		t.Fatalf("CreateBot() error = %v", err)
+	}
+
+	goroutines := runtime.NumGoroutine()
+	_, err = west.Bot.OutputAudio(ctx, bot.ID, &recallaigo.OutputAudioRequest{
+		Kind:   recallaigo.OutputAudioKindMp3,
+		Source: strings.NewReader(strings.Repeat("some audio ", 100000)),
+	})
+	var mismatch *recallaigo.RegionMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("OutputAudio() error = %v, want a *RegionMismatchError", err)
+	}
+
+	// The writer of the streamed body stops once the body is closed.
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > goroutines; {
+		if time.Now().After(deadline) {
+			t.Fatal("OutputAudio() left the writer of the streamed body blocked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,109 @@
+package recallaigo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// RequestOptions configures a request built by NewRequest.
+type RequestOptions struct {
+	// The API version of the endpoint. Defaults to APIVersionV1.
+	APIVersion APIVersion
+	// The query parameters of the request.
+	Query url.Values
+	// The body of the request, encoded as JSON. Nil sends no body.
+	Body any
+}
+
+// NewRequest builds a request to an endpoint of the API that this package does not model yet,
+// e.g. a beta endpoint. path is relative to the API version, e.g. "bot/{id}/new_endpoint".
+// Send it with Do, which applies the authorization, retries and error handling of the client:
+//
+//	req, err := client.NewRequest(ctx, http.MethodGet, "bot/"+botID+"/new_endpoint")
+//	if err != nil {
+//		return err
+//	}
+//	var out NewEndpointResponse
+//	_, err = client.Do(req, &out)
+func (c *Client) NewRequest(ctx context.Context, method, path string, opts ...RequestOptions) (*http.Request, error) {
+	var opt RequestOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.APIVersion == "" {
+		opt.APIVersion = APIVersionV1
+	}
+
+	u, err := c.baseUrl.Parse(fmt.Sprintf("api/%s/%s", opt.APIVersion, path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request URL: %w", err)
+	}
+	u.RawQuery = opt.Query.Encode()
+
+	var body io.Reader
+	if opt.Body != nil {
+		data, err := json.Marshal(opt.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new HTTP request: %w", err)
+	}
+	c.setHeaders(req)
+	return req, nil
+}
+
+// Do sends a request built by NewRequest and decodes its JSON response into v, honoring the
+// decoding mode of the client. If v is an io.Writer, the response body is copied into it instead,
+// and if v is nil, it is discarded. Non-2xx responses return an *Error.
+//
+// The body of the returned response is already closed; its status and headers remain readable.
+func (c *Client) Do(req *http.Request, v any) (*http.Response, error) {
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch v := v.(type) {
+	case nil:
+		if _, err := io.Copy(io.Discard, res.Body); err != nil {
+			return res, fmt.Errorf("failed to read response body: %w", err)
+		}
+	case io.Writer:
+		if _, err := io.Copy(v, res.Body); err != nil {
+			return res, fmt.Errorf("failed to read response body: %w", err)
+		}
+	default:
+		// Empty bodies, e.g. of 204 No Content responses, leave v unchanged.
+		if err := c.decode(res, v); err != nil && err != io.EOF {
+			return res, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return res, nil
+}
+
+// Do calls an endpoint that this package does not model yet and decodes its JSON response
+// into a T, like Client.NewRequest followed by Client.Do. body is encoded as JSON, nil sends none.
+//
+//	thing, err := recallaigo.Do[Thing](ctx, client, http.MethodGet, "bot/"+botID+"/thing", nil)
+func Do[T any](ctx context.Context, client *Client, method, path string, body any) (T, error) {
+	var out T
+	req, err := client.NewRequest(ctx, method, path, RequestOptions{Body: body})
+	if err != nil {
+		return out, err
+	}
+	if _, err := client.Do(req, &out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
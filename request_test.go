@@ -0,0 +1,173 @@
+package recallaigo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestNewRequestDo(t *testing.T) {
+	var got struct {
+		Method string
+		Path   string
+		Query  string
+		Auth   string
+		Body   map[string]any
+	}
+	router := testutil.NewRouter()
+	router.Handle("/api/", func(req *http.Request) *http.Response {
+		got.Method, got.Path, got.Query = req.Method, req.URL.Path, req.URL.RawQuery
+		got.Auth = req.Header.Get("Authorization")
+		got.Body = nil
+		if req.Body != nil {
+			json.NewDecoder(req.Body).Decode(&got.Body)
+		}
+		if req.URL.Path == "/api/v1/missing" {
+			return testutil.NewFileResponse(t, "test_data/error.json", http.StatusNotFound)
+		}
+		if req.Method == http.MethodDelete {
+			return testutil.NewStringResponse("", http.StatusNoContent)
+		}
+		return testutil.NewStringResponse(`{"id": "thing_id", "count": 2}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+	ctx := context.Background()
+
+	t.Run("decodes JSON", func(t *testing.T) {
+		req, err := client.NewRequest(ctx, http.MethodPost, "bot/bot_id/thing", recallaigo.RequestOptions{
+			APIVersion: recallaigo.APIVersionV2Beta,
+			Query:      url.Values{"mode": {"fast"}},
+			Body:       map[string]any{"name": "thing"},
+		})
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+
+		var out struct {
+			ID    string `json:"id"`
+			Count int    `json:"count"`
+		}
+		res, err := client.Do(req, &out)
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		if res.StatusCode != http.StatusOK || out.ID != "thing_id" || out.Count != 2 {
+			t.Errorf("Do() = %d, %+v", res.StatusCode, out)
+		}
+		if got.Method != http.MethodPost || got.Path != "/api/v2beta/bot/bot_id/thing" || got.Query != "mode=fast" {
+			t.Errorf("sent %s %s?%s", got.Method, got.Path, got.Query)
+		}
+		if got.Auth != "Token some_token" {
+			t.Errorf("Authorization = %q", got.Auth)
+		}
+		if got.Body["name"] != "thing" {
+			t.Errorf("body = %v", got.Body)
+		}
+	})
+
+	t.Run("copies into writers", func(t *testing.T) {
+		req, err := client.NewRequest(ctx, http.MethodGet, "thing")
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		var buf bytes.Buffer
+		if _, err := client.Do(req, &buf); err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		if buf.String() != `{"id": "thing_id", "count": 2}` {
+			t.Errorf("body = %q", buf.String())
+		}
+		if got.Path != "/api/v1/thing" || got.Body != nil {
+			t.Errorf("sent %s with body %v", got.Path, got.Body)
+		}
+	})
+
+	t.Run("accepts empty responses", func(t *testing.T) {
+		req, err := client.NewRequest(ctx, http.MethodDelete, "thing/thing_id")
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		var out map[string]any
+		res, err := client.Do(req, &out)
+		if err != nil || res.StatusCode != http.StatusNoContent {
+			t.Errorf("Do() = %v, %v", res, err)
+		}
+	})
+
+	t.Run("returns API errors", func(t *testing.T) {
+		req, err := client.NewRequest(ctx, http.MethodGet, "missing")
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		if _, err := client.Do(req, nil); !recallaigo.IsNotFound(err) {
+			t.Errorf("Do() error = %v, want a not found error", err)
+		}
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		attempts := 0
+		c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+			attempts++
+			body, _ := io.ReadAll(req.Body)
+			if attempts == 1 {
+				return testutil.NewStringResponse("", http.StatusTooManyRequests)
+			}
+			return testutil.NewStringResponse(string(body), http.StatusOK)
+		})
+		client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c),
+			recallaigo.WithRetry(recallaigo.RetryPolicy{MaxRetries: 1, Backoff: recallaigo.NoBackoff}))
+
+		req, err := client.NewRequest(ctx, http.MethodPost, "thing", recallaigo.RequestOptions{Body: map[string]string{"a": "b"}})
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		var out map[string]string
+		if _, err := client.Do(req, &out); err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		if attempts != 2 || out["a"] != "b" {
+			t.Errorf("attempts = %d, out = %v", attempts, out)
+		}
+	})
+}
+
+func TestDo(t *testing.T) {
+	type thing struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	router := testutil.NewRouter()
+	router.Handle("POST /api/v1/thing", func(req *http.Request) *http.Response {
+		var in thing
+		json.NewDecoder(req.Body).Decode(&in)
+		return testutil.NewStringResponse(`{"id": "thing_id", "name": "`+in.Name+`"}`, http.StatusCreated)
+	})
+	router.HandleString("GET /api/v1/things", `[{"id": "a"}, {"id": "b"}]`, http.StatusOK)
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+	ctx := context.Background()
+
+	created, err := recallaigo.Do[*thing](ctx, client, http.MethodPost, "thing", thing{Name: "new"})
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if *created != (thing{ID: "thing_id", Name: "new"}) {
+		t.Errorf("Do() = %+v", created)
+	}
+
+	things, err := recallaigo.Do[[]thing](ctx, client, http.MethodGet, "things", nil)
+	if err != nil || len(things) != 2 || things[1].ID != "b" {
+		t.Errorf("Do() = %+v, %v", things, err)
+	}
+
+	if _, err := recallaigo.Do[thing](ctx, client, http.MethodGet, "missing", nil); !recallaigo.IsNotFound(err) {
+		t.Errorf("Do() error = %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,90 @@
+package recallaigo
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// ExpiringMedia is the media of a bot that is deleted soon by the retention policy of Recall.ai.
+type ExpiringMedia struct {
+	Bot *Bot
+	// When the first of the media is deleted.
+	ExpiresAt time.Time
+	// The recordings expiring, empty if only the media retention end of the bot is known.
+	Recordings []Recording
+}
+
+// RetentionScanOptions configures ScanExpiringMedia and FindExpiringMedia.
+type RetentionScanOptions struct {
+	// Filters the scanned bots, e.g. by JoinAtAfter. Page is ignored.
+	Params ListBotsParams
+	// The clock the expiry times are compared to. Defaults to SystemClock.
+	Clock Clock
+}
+
+// ScanExpiringMedia lists the bots and calls fn for each bot whose media expires within the given
+// duration, e.g. to archive it before it is deleted. The expiry is read from the recordings of the
+// bot, or from its media_retention_end if they have none. Media that already expired is skipped.
+// The scan stops at the first error of fn, which is returned.
+func ScanExpiringMedia(ctx context.Context, bots BotService, within time.Duration, fn func(ExpiringMedia) error, opts ...RetentionScanOptions) error {
+	var opt RetentionScanOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Clock == nil {
+		opt.Clock = SystemClock{}
+	}
+
+	now := opt.Clock.Now()
+	deadline := now.Add(within)
+	expiring := func(t time.Time) bool {
+		return !t.IsZero() && t.After(now) && !t.After(deadline)
+	}
+
+	return forEachBot(ctx, bots, opt.Params, func(_ int, bot *Bot) error {
+		if bot.CurrentStatus() == StatusMediaExpired {
+			return nil
+		}
+
+		media := ExpiringMedia{Bot: bot}
+		known := false
+		for _, recording := range bot.Recordings {
+			if recording.ExpiresAt.IsZero() {
+				continue
+			}
+			known = true
+			if expiring(recording.ExpiresAt.Time) {
+				media.Recordings = append(media.Recordings, recording)
+				if media.ExpiresAt.IsZero() || recording.ExpiresAt.Before(media.ExpiresAt) {
+					media.ExpiresAt = recording.ExpiresAt.Time
+				}
+			}
+		}
+		if !known && expiring(bot.MediaRetentionEnd.Time) {
+			media.ExpiresAt = bot.MediaRetentionEnd.Time
+		}
+
+		if media.ExpiresAt.IsZero() {
+			return nil
+		}
+		return fn(media)
+	})
+}
+
+// FindExpiringMedia is like ScanExpiringMedia, returning the expiring media sorted by expiry.
+func FindExpiringMedia(ctx context.Context, bots BotService, within time.Duration, opts ...RetentionScanOptions) ([]ExpiringMedia, error) {
+	var found []ExpiringMedia
+	err := ScanExpiringMedia(ctx, bots, within, func(media ExpiringMedia) error {
+		found = append(found, media)
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(found, func(a, b ExpiringMedia) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+	return found, nil
+}
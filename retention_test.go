@@ -0,0 +1,99 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestFindExpiringMedia(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	in := func(d time.Duration) string {
+		return now.Add(d).Format(time.RFC3339)
+	}
+	const day = 24 * time.Hour
+
+	router := testutil.NewRouter()
+	router.Handle("GET /api/v1/bot", func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("page") == "2" {
+			return testutil.NewStringResponse(fmt.Sprintf(`{"next": null, "results": [
+				{"id": "expired_status", "media_retention_end": %q, "status_changes": [{"code": "media_expired"}]},
+				{"id": "recording", "recordings": [{"id": "rec_c", "expires_at": %q}]}
+			]}`, in(day), in(5*day)), http.StatusOK)
+		}
+		return testutil.NewStringResponse(fmt.Sprintf(`{"next": "https://us-east-1.recall.ai/api/v1/bot/?page=2", "results": [
+			{"id": "recordings", "media_retention_end": %q, "recordings": [
+				{"id": "rec_a", "expires_at": %q},
+				{"id": "rec_b", "expires_at": %q}
+			]},
+			{"id": "retention_end", "media_retention_end": %q},
+			{"id": "already_expired", "media_retention_end": %q},
+			{"id": "unknown"}
+		]}`, in(day), in(2*day), in(10*day), in(day), in(-day)), http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+	opts := recallaigo.RetentionScanOptions{Clock: recalltest.NewFakeClock(now)}
+
+	got, err := recallaigo.FindExpiringMedia(context.Background(), client.Bot, 7*day, opts)
+	if err != nil {
+		t.Fatalf("FindExpiringMedia() error = %v", err)
+	}
+
+	type result struct {
+		botID      string
+		expiresAt  time.Time
+		recordings []string
+	}
+	want := []result{
+		{"retention_end", now.Add(day), nil},
+		{"recordings", now.Add(2 * day), []string{"rec_a"}},
+		{"recording", now.Add(5 * day), []string{"rec_c"}},
+	}
+	var results []result
+	for _, media := range got {
+		r := result{botID: media.Bot.ID, expiresAt: media.ExpiresAt}
+		for _, recording := range media.Recordings {
+			r.recordings = append(r.recordings, recording.ID)
+		}
+		results = append(results, r)
+	}
+	if !slices.EqualFunc(results, want, func(a, b result) bool {
+		return a.botID == b.botID && a.expiresAt.Equal(b.expiresAt) && slices.Equal(a.recordings, b.recordings)
+	}) {
+		t.Errorf("FindExpiringMedia() = %+v, want %+v", results, want)
+	}
+}
+
+func TestScanExpiringMediaStopsOnError(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour).Format(time.RFC3339)
+
+	router := testutil.NewRouter()
+	router.HandleString("GET /api/v1/bot", fmt.Sprintf(`{"next": null, "results": [
+		{"id": "a", "media_retention_end": %q},
+		{"id": "b", "media_retention_end": %q}
+	]}`, expiresAt, expiresAt), http.StatusOK)
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+	opts := recallaigo.RetentionScanOptions{Clock: recalltest.NewFakeClock(now)}
+
+	errArchive := errors.New("archive failed")
+	var scanned []string
+	err := recallaigo.ScanExpiringMedia(context.Background(), client.Bot, 24*time.Hour, func(media recallaigo.ExpiringMedia) error {
+		scanned = append(scanned, media.Bot.ID)
+		return errArchive
+	}, opts)
+	if !errors.Is(err, errArchive) {
+		t.Errorf("ScanExpiringMedia() error = %v, want %v", err, errArchive)
+	}
+	if !slices.Equal(scanned, []string{"a"}) {
+		t.Errorf("scanned bots = %v, want [a]", scanned)
+	}
+}
@@ -0,0 +1,159 @@
+package recallaigo
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Backoff decides how long to wait before retrying a failed request.
+// attempt is the number of the retry, starting at 1.
+type Backoff interface {
+	Next(attempt int) time.Duration
+}
+
+// ExponentialBackoff doubles the delay with every retry, with random jitter of up to half the delay.
+type ExponentialBackoff struct {
+	// The delay before the first retry. Defaults to 500 milliseconds.
+	Initial time.Duration
+	// The maximum delay. Defaults to 30 seconds.
+	Max time.Duration
+}
+
+func (b ExponentialBackoff) Next(attempt int) time.Duration {
+	initial, maxDelay := b.Initial, b.Max
+	if initial <= 0 {
+		initial = 500 * time.Millisecond
+	}
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+
+	delay := initial
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxDelay)
+	return delay - rand.N(delay/2+1)
+}
+
+// ConstantBackoff waits the same time before every retry.
+type ConstantBackoff time.Duration
+
+func (b ConstantBackoff) Next(int) time.Duration {
+	return time.Duration(b)
+}
+
+// NoBackoff retries immediately. It is meant for tests of retry behavior.
+var NoBackoff Backoff = ConstantBackoff(0)
+
+// RetryPolicy configures the retries of failed requests.
+//
+// Rate limited requests (429) are retried for every method, as the API did not process them.
+// Server errors (5xx) and network errors are only retried for GET, HEAD, PUT and DELETE requests,
+// so that e.g. a bot is never created twice. Requests with a streamed body, such as an
+// OutputAudioRequest with a Source, are not retried.
+type RetryPolicy struct {
+	// The maximum number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// The delay between attempts. A longer Retry-After of the response takes precedence.
+	// Defaults to ExponentialBackoff.
+	Backoff Backoff
+}
+
+// WithRetry enables retries of failed requests. By default requests are not retried.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if policy.Backoff == nil {
+			policy.Backoff = ExponentialBackoff{}
+		}
+		c.retry = policy
+	}
+}
+
+// do sends the request, retrying it according to the retry policy of the client.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := c.httpClient.Do(req)
+		if attempt > c.retry.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		delay := c.retry.Backoff.Next(attempt)
+		if res != nil {
+			delay = max(delay, retryAfter(res))
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		if err := sleep(req.Context(), c.clock, delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	// Streamed bodies are consumed by the first attempt.
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	if err != nil {
+		// Do not retry requests the caller gave up on.
+		return req.Context().Err() == nil
+	}
+	return res.StatusCode >= 500
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the response, in seconds.
+func retryAfter(res *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// rewindRequest returns a copy of the request with its body reset for another attempt.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// sleep waits for d on the clock, or until ctx is done.
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	ticker := clock.NewTicker(d)
+	defer ticker.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ticker.C():
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package recallaigo_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(*recallaigo.Client) error
+		statuses     []int
+		maxRetries   int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name: "retries server errors of GET requests",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+				return err
+			},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			maxRetries:   3,
+			wantAttempts: 3,
+		},
+		{
+			name: "gives up after the maximum retries",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+				return err
+			},
+			statuses:     []int{http.StatusServiceUnavailable},
+			maxRetries:   2,
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name: "does not retry server errors of POST requests",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.CreateBot(context.Background(), &recallaigo.CreateBotRequest{MeetingURL: "https://zoom.us/j/123", BotName: "Test Bot"})
+				return err
+			},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:   3,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name: "retries rate limited POST requests with their body",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.CreateBot(context.Background(), &recallaigo.CreateBotRequest{MeetingURL: "https://zoom.us/j/123", BotName: "Test Bot"})
+				return err
+			},
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:   3,
+			wantAttempts: 2,
+		},
+		{
+			name: "does not retry without a policy",
+			call: func(client *recallaigo.Client) error {
+				_, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+				return err
+			},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				if req.Body != nil {
+					if body, _ := io.ReadAll(req.Body); len(body) == 0 {
+						t.Error("request body is empty")
+					}
+				}
+				status := tt.statuses[min(attempts, len(tt.statuses)-1)]
+				attempts++
+				if status != http.StatusOK {
+					return testutil.NewStringResponse(`{"detail": "try again"}`, status)
+				}
+				return testutil.NewFileResponse(t, "test_data/retrieve_bot.json", status)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithRetry(recallaigo.RetryPolicy{
+				MaxRetries: tt.maxRetries,
+				Backoff:    recallaigo.NoBackoff,
+			}))
+
+			err := tt.call(client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := recallaigo.ExponentialBackoff{Initial: time.Second, Max: 5 * time.Second}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Second},
+		{attempt: 2, max: 2 * time.Second},
+		{attempt: 3, max: 4 * time.Second},
+		{attempt: 10, max: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		got := backoff.Next(tt.attempt)
+		if got < tt.max/2 || got > tt.max {
+			t.Errorf("Next(%d) = %v, want between %v and %v", tt.attempt, got, tt.max/2, tt.max)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package recallaigo
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Row is a flat record with stable column names, suitable for warehouse load jobs
+// such as BigQuery or Snowflake.
+type Row interface {
+	// The column names, in order. They match the JSON field names of the row.
+	Columns() []string
+	// The values of the columns formatted as strings.
+	Values() []string
+}
+
+// TranscriptRow is a single transcribed word.
+type TranscriptRow struct {
+	BotID       string `json:"bot_id"`
+	RecordingID string `json:"recording_id"`
+	// The index of the transcript entry the word belongs to.
+	EntryIndex int `json:"entry_index"`
+	// The index of the word in its entry.
+	WordIndex      int     `json:"word_index"`
+	Speaker        string  `json:"speaker"`
+	SpeakerID      int     `json:"speaker_id"`
+	Language       string  `json:"language"`
+	Text           string  `json:"text"`
+	StartTimestamp float64 `json:"start_timestamp"`
+	EndTimestamp   float64 `json:"end_timestamp"`
+	Confidence     float64 `json:"confidence"`
+}
+
+func (TranscriptRow) Columns() []string {
+	return []string{"bot_id", "recording_id", "entry_index", "word_index", "speaker", "speaker_id", "language", "text", "start_timestamp", "end_timestamp", "confidence"}
+}
+
+func (r TranscriptRow) Values() []string {
+	return []string{
+		r.BotID,
+		r.RecordingID,
+		strconv.Itoa(r.EntryIndex),
+		strconv.Itoa(r.WordIndex),
+		r.Speaker,
+		strconv.Itoa(r.SpeakerID),
+		r.Language,
+		r.Text,
+		formatFloat(r.StartTimestamp),
+		formatFloat(r.EndTimestamp),
+		formatFloat(r.Confidence),
+	}
+}
+
+// ParticipantEventRow is a single event of a meeting participant.
+type ParticipantEventRow struct {
+	BotID           string `json:"bot_id"`
+	RecordingID     string `json:"recording_id"`
+	ParticipantID   int    `json:"participant_id"`
+	ParticipantName string `json:"participant_name"`
+	IsHost          bool   `json:"is_host"`
+	Platform        string `json:"platform"`
+	EventCode       string `json:"event_code"`
+	EventCreatedAt  string `json:"event_created_at"`
+}
+
+func (ParticipantEventRow) Columns() []string {
+	return []string{"bot_id", "recording_id", "participant_id", "participant_name", "is_host", "platform", "event_code", "event_created_at"}
+}
+
+func (r ParticipantEventRow) Values() []string {
+	return []string{
+		r.BotID,
+		r.RecordingID,
+		strconv.Itoa(r.ParticipantID),
+		r.ParticipantName,
+		strconv.FormatBool(r.IsHost),
+		r.Platform,
+		r.EventCode,
+		r.EventCreatedAt,
+	}
+}
+
+// StatusChangeRow is a single status change of a bot.
+type StatusChangeRow struct {
+	BotID       string `json:"bot_id"`
+	RecordingID string `json:"recording_id"`
+	Code        string `json:"code"`
+	SubCode     string `json:"sub_code"`
+	Message     string `json:"message"`
+	CreatedAt   string `json:"created_at"`
+}
+
+func (StatusChangeRow) Columns() []string {
+	return []string{"bot_id", "recording_id", "code", "sub_code", "message", "created_at"}
+}
+
+func (r StatusChangeRow) Values() []string {
+	return []string{r.BotID, r.RecordingID, r.Code, r.SubCode, r.Message, r.CreatedAt}
+}
+
+// TranscriptRows flattens the transcript into one row per word.
+func TranscriptRows(botID, recordingID string, entries []TranscriptEntry) []TranscriptRow {
+	var rows []TranscriptRow
+	for i, entry := range entries {
+		for j, word := range entry.Words {
+			language := word.Language
+			if language == "" {
+				language = entry.Language
+			}
+			rows = append(rows, TranscriptRow{
+				BotID:          botID,
+				RecordingID:    recordingID,
+				EntryIndex:     i,
+				WordIndex:      j,
+				Speaker:        entry.Speaker,
+				SpeakerID:      entry.SpeakerID,
+				Language:       language,
+				Text:           word.Text,
+				StartTimestamp: word.StartTimestamp,
+				EndTimestamp:   word.EndTimestamp,
+				Confidence:     word.Confidence,
+			})
+		}
+	}
+	return rows
+}
+
+// ParticipantEventRows flattens the participants of the bot into one row per participant event.
+func ParticipantEventRows(bot *Bot) []ParticipantEventRow {
+	var rows []ParticipantEventRow
+	for _, participant := range bot.MeetingParticipants {
+		for _, event := range participant.Events {
+			rows = append(rows, ParticipantEventRow{
+				BotID:           bot.ID,
+				RecordingID:     bot.currentRecordingID(),
+				ParticipantID:   participant.ID,
+				ParticipantName: participant.Name,
+				IsHost:          participant.IsHost,
+				Platform:        participant.Platform,
+				EventCode:       event.Code,
+				EventCreatedAt:  formatRowTime(event.CreatedAt),
+			})
+		}
+	}
+	return rows
+}
+
+// StatusChangeRows flattens the status changes of the bot into one row per change.
+func StatusChangeRows(bot *Bot) []StatusChangeRow {
+	rows := make([]StatusChangeRow, len(bot.StatusChanges))
+	for i, change := range bot.StatusChanges {
+		rows[i] = StatusChangeRow{
+			BotID:       bot.ID,
+			RecordingID: bot.currentRecordingID(),
+			Code:        string(change.Code),
+			SubCode:     string(change.SubCode),
+			Message:     change.Message,
+			CreatedAt:   formatRowTime(change.CreatedAt),
+		}
+	}
+	return rows
+}
+
+// WriteRowsCSV writes the rows as CSV with a header row of the column names.
+// Nil rows, e.g. of a []*StatusChangeRow, are written as rows of empty cells.
+func WriteRowsCSV[T Row](w io.Writer, rows []T) error {
+	columns := newRow[T]().Columns()
+	cw := csv.NewWriter(w)
+	cw.Write(columns)
+	for _, row := range rows {
+		if isNilRow(row) {
+			cw.Write(make([]string, len(columns)))
+			continue
+		}
+		cw.Write(row.Values())
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	return nil
+}
+
+// WriteRowsJSONL writes one JSON encoded row per line, the newline delimited JSON format
+// accepted by warehouse load jobs.
+func WriteRowsJSONL[T Row](w io.Writer, rows []T) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return fmt.Errorf("failed to encode row: %w", err)
+		}
+	}
+	return bw.Flush()
+}
+
+// newRow returns the zero row of T, or a pointer to it if T is a pointer type, so that its
+// methods can be called.
+func newRow[T Row]() T {
+	var row T
+	if t := reflect.TypeFor[T](); t.Kind() == reflect.Pointer {
+		row = reflect.New(t.Elem()).Interface().(T)
+	}
+	return row
+}
+
+// isNilRow reports whether the row is a nil pointer, whose values cannot be read.
+func isNilRow[T Row](row T) bool {
+	v := reflect.ValueOf(row)
+	return !v.IsValid() || v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+// formatRowTime formats the time as RFC 3339 in UTC, keeping fractional seconds.
+func formatRowTime(t Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,97 @@
+package recallaigo_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestRows(t *testing.T) {
+	var bot recallaigo.Bot
+	err := json.Unmarshal([]byte(`{
+		"id": "bot",
+		"recording": "rec",
+		"status_changes": [{"code": "done", "sub_code": "", "message": "Bot is done", "created_at": "2025-03-18T10:30:00Z"}],
+		"meeting_participants": [{"id": 1, "name": "Alice", "is_host": true, "platform": "desktop", "events": [
+			{"code": "join", "created_at": "2025-03-18T10:00:00Z"},
+			{"code": "leave", "created_at": "2025-03-18T10:30:00Z"}
+		]}]
+	}`), &bot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("WriteRowsCSV", func(t *testing.T) {
+		rows := recallaigo.TranscriptRows("bot", "rec", []recallaigo.TranscriptEntry{
+			newTranscriptEntry("Alice", 1, 0.5, "Hello, world"),
+		})
+
+		var b strings.Builder
+		if err := recallaigo.WriteRowsCSV(&b, rows); err != nil {
+			t.Fatalf("WriteRowsCSV() error = %v", err)
+		}
+
+		want := `bot_id,recording_id,entry_index,word_index,speaker,speaker_id,language,text,start_timestamp,end_timestamp,confidence
+bot,rec,0,0,Alice,1,en,"Hello,",0.5,1.5,0.9
+bot,rec,0,1,Alice,1,en,world,1.5,2.5,0.9
+`
+		if b.String() != want {
+			t.Errorf("WriteRowsCSV() = %s, want %s", b.String(), want)
+		}
+	})
+
+	t.Run("WriteRowsCSV writes zero and nil rows as empty cells", func(t *testing.T) {
+		var b strings.Builder
+		if err := recallaigo.WriteRowsCSV(&b, []*recallaigo.StatusChangeRow{{}, nil, {BotID: "bot", Code: "done"}}); err != nil {
+			t.Fatalf("WriteRowsCSV() error = %v", err)
+		}
+
+		want := `bot_id,recording_id,code,sub_code,message,created_at
+,,,,,
+,,,,,
+bot,,done,,,
+`
+		if b.String() != want {
+			t.Errorf("WriteRowsCSV() = %s, want %s", b.String(), want)
+		}
+	})
+
+	t.Run("WriteRowsJSONL", func(t *testing.T) {
+		var b strings.Builder
+		if err := recallaigo.WriteRowsJSONL(&b, recallaigo.StatusChangeRows(&bot)); err != nil {
+			t.Fatalf("WriteRowsJSONL() error = %v", err)
+		}
+
+		want := `{"bot_id":"bot","recording_id":"rec","code":"done","sub_code":"","message":"Bot is done","created_at":"2025-03-18T10:30:00Z"}
+`
+		if b.String() != want {
+			t.Errorf("WriteRowsJSONL() = %s, want %s", b.String(), want)
+		}
+	})
+
+	t.Run("ParticipantEventRows", func(t *testing.T) {
+		rows := recallaigo.ParticipantEventRows(&bot)
+		if len(rows) != 2 || rows[1].EventCode != "leave" || rows[1].RecordingID != "rec" {
+			t.Errorf("ParticipantEventRows() = %+v, want a row per event", rows)
+		}
+	})
+
+	t.Run("columns match JSON fields", func(t *testing.T) {
+		for _, row := range []recallaigo.Row{recallaigo.TranscriptRow{}, recallaigo.ParticipantEventRow{}, recallaigo.StatusChangeRow{}} {
+			typ := reflect.TypeOf(row)
+			var fields []string
+			for i := 0; i < typ.NumField(); i++ {
+				fields = append(fields, typ.Field(i).Tag.Get("json"))
+			}
+			if !reflect.DeepEqual(fields, row.Columns()) {
+				t.Errorf("%s columns = %v, want %v", typ.Name(), row.Columns(), fields)
+			}
+			if len(row.Values()) != len(fields) {
+				t.Errorf("%s has %d values, want %d", typ.Name(), len(row.Values()), len(fields))
+			}
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package recallaigo
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// botIDPattern matches the IDs of bots, which are UUIDs.
+var botIDPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// ParseBotID returns the canonical form of a bot ID given by a user, e.g. to import a bot into
+// a configuration tool: surrounding whitespace is trimmed and the UUID is lowercased.
+func ParseBotID(id string) (string, error) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if !botIDPattern.MatchString(id) {
+		return "", fmt.Errorf("invalid bot ID %q: expected a UUID", id)
+	}
+	return id, nil
+}
+
+// UpsertScheduledBot makes the scheduled bot with the given ID match the request. The bot is
+// updated if it exists, and otherwise created, e.g. when botID is empty or the bot was deleted.
+// The ID of the returned bot identifies it from then on, as a created bot gets a new one.
+//
+// Together with IgnoreNotFound for deletions, it lets declarative tools such as Terraform
+// providers converge on the desired bots however often they are applied.
+func (c *BotClient) UpsertScheduledBot(ctx context.Context, botID string, request *CreateBotRequest) (*Bot, error) {
+	if botID != "" {
+		bot, err := c.UpdateScheduledBot(ctx, botID, request)
+		if !IsNotFound(err) {
+			return bot, err
+		}
+	}
+	return c.CreateBot(ctx, request)
+}
+
+// ImportScheduledBot retrieves the scheduled bot with the given ID, e.g. to import it into
+// the state of a configuration tool. It fails for bots that started joining their call,
+// which can no longer be updated, and for bots without a join time.
+func (c *BotClient) ImportScheduledBot(ctx context.Context, botID string) (*Bot, error) {
+	botID, err := ParseBotID(botID)
+	if err != nil {
+		return nil, err
+	}
+
+	bot, err := c.RetrieveBot(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	if !bot.isScheduled() {
+		return nil, fmt.Errorf("bot %s is not scheduled: it is in status %q", botID, bot.CurrentStatus())
+	}
+	return bot, nil
+}
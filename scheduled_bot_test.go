@@ -0,0 +1,137 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestParseBotID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{id: "3fa85f64-5717-4562-b3fc-2c963f66afa6", want: "3fa85f64-5717-4562-b3fc-2c963f66afa6"},
+		{id: " 3FA85F64-5717-4562-B3FC-2C963F66AFA6\n", want: "3fa85f64-5717-4562-b3fc-2c963f66afa6"},
+		{id: "3fa85f64571745624b3fc2c963f66afa6", wantErr: true},
+		{id: "bot_id", wantErr: true},
+		{id: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := recallaigo.ParseBotID(tt.id)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseBotID(%q) = %q, %v, want %q, error %v", tt.id, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestUpsertScheduledBot(t *testing.T) {
+	tests := []struct {
+		name         string
+		botID        string
+		updateStatus int
+		wantRequests []string
+	}{
+		{
+			name:         "updates existing bots",
+			botID:        "bot_id",
+			updateStatus: http.StatusOK,
+			wantRequests: []string{"PATCH /api/v1/bot/bot_id"},
+		},
+		{
+			name:         "creates deleted bots",
+			botID:        "bot_id",
+			updateStatus: http.StatusNotFound,
+			wantRequests: []string{"PATCH /api/v1/bot/bot_id", "POST /api/v1/bot"},
+		},
+		{
+			name:         "creates bots without ID",
+			wantRequests: []string{"POST /api/v1/bot"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			router := testutil.NewRouter()
+			router.Handle("PATCH /api/v1/bot/{id}", func(req *http.Request) *http.Response {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				if tt.updateStatus != http.StatusOK {
+					return testutil.NewFileResponse(t, "test_data/error.json", tt.updateStatus)
+				}
+				return testutil.NewFileResponse(t, "test_data/update_scheduled_bot.json", http.StatusOK)
+			})
+			router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+			})
+
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+			bot, err := client.Bot.UpsertScheduledBot(context.Background(), tt.botID, &recallaigo.CreateBotRequest{
+				MeetingURL:    "https://test.com",
+				BotName:       "Test Bot",
+				RecordingMode: recallaigo.SpeakerView,
+			})
+			if err != nil {
+				t.Fatalf("UpsertScheduledBot() error = %v", err)
+			}
+			if bot.ID == "" {
+				t.Error("UpsertScheduledBot() returned a bot without ID")
+			}
+			if strings.Join(requests, ", ") != strings.Join(tt.wantRequests, ", ") {
+				t.Errorf("requests = %v, want %v", requests, tt.wantRequests)
+			}
+		})
+	}
+
+	t.Run("returns other errors", func(t *testing.T) {
+		c := testutil.NewMockedClient(t, "test_data/error.json", http.StatusBadRequest)
+		client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+		_, err := client.Bot.UpsertScheduledBot(context.Background(), "bot_id", &recallaigo.CreateBotRequest{
+			MeetingURL: "https://test.com",
+			BotName:    "Test Bot",
+		})
+		if err == nil {
+			t.Error("UpsertScheduledBot() error = nil, want an error")
+		}
+	})
+}
+
+func TestImportScheduledBot(t *testing.T) {
+	const botID = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+
+	tests := []struct {
+		name    string
+		id      string
+		body    string
+		wantErr bool
+	}{
+		{name: "imports scheduled bots", id: botID, body: `{"id": "` + botID + `", "join_at": "2025-03-18T10:13:10.433Z"}`},
+		{name: "imports ready bots", id: botID, body: `{"id": "` + botID + `", "join_at": "2025-03-18T10:13:10.433Z", "status_changes": [{"code": "ready"}]}`},
+		{name: "rejects bots without a join time", id: botID, body: `{"id": "` + botID + `"}`, wantErr: true},
+		{name: "rejects bots that joined", id: botID, body: `{"id": "` + botID + `", "join_at": "2025-03-18T10:13:10.433Z", "status_changes": [{"code": "ready"}, {"code": "joining_call"}]}`, wantErr: true},
+		{name: "rejects invalid IDs", id: "bot_id", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := testutil.NewRouter()
+			router.HandleString("GET /api/v1/bot/{id}", tt.body, http.StatusOK)
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+			bot, err := client.Bot.ImportScheduledBot(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImportScheduledBot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && bot.ID != botID {
+				t.Errorf("ImportScheduledBot() ID = %q, want %q", bot.ID, botID)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package recallaigo
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WithSingleflight makes concurrent identical GET requests of the client share a single API request,
+// e.g. when a burst of webhooks makes many goroutines call RetrieveBot or GetBotTranscript for the
+// same bot. Callers joining a request in flight receive a copy of its response; a caller whose
+// context is done stops waiting without affecting the others. Downloads of media are never shared.
+func WithSingleflight(enabled bool) ClientOption {
+	return func(c *Client) {
+		if enabled {
+			c.flights = &flightGroup{calls: make(map[string]*flight)}
+		} else {
+			c.flights = nil
+		}
+	}
+}
+
+// flightGroup tracks the GET requests in flight by URL.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// flight is a request in flight. res, body and err are set before done is closed.
+type flight struct {
+	req  *http.Request
+	done chan struct{}
+	res  *http.Response
+	body []byte
+	err  error
+}
+
+// doShared sends the request like do, sharing it with identical concurrent GET requests if
+// singleflight is enabled.
+func (c *Client) doShared(req *http.Request) (*http.Response, error) {
+	if c.flights == nil || req.Method != http.MethodGet {
+		return c.do(req)
+	}
+
+	key := req.URL.String()
+	c.flights.mu.Lock()
+	if f, ok := c.flights.calls[key]; ok {
+		c.flights.mu.Unlock()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-f.done:
+		}
+		// The request failed because the caller who sent it gave up; send our own.
+		if f.err != nil && f.req.Context().Err() != nil {
+			return c.do(req)
+		}
+		return f.response(req), f.err
+	}
+
+	f := &flight{req: req, done: make(chan struct{})}
+	c.flights.calls[key] = f
+	c.flights.mu.Unlock()
+
+	res, err := c.do(req)
+	if err == nil {
+		f.body, err = io.ReadAll(res.Body)
+		res.Body.Close()
+	}
+	f.res, f.err = res, err
+
+	c.flights.mu.Lock()
+	delete(c.flights.calls, key)
+	c.flights.mu.Unlock()
+	close(f.done)
+
+	return f.response(req), f.err
+}
+
+// response returns a copy of the shared response for the request.
+func (f *flight) response(req *http.Request) *http.Response {
+	if f.err != nil {
+		return nil
+	}
+
+	res := *f.res
+	res.Header = f.res.Header.Clone()
+	res.Body = io.NopCloser(bytes.NewReader(f.body))
+	res.Request = req
+	return &res
+}
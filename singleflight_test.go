@@ -0,0 +1,117 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestSingleflight(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   bool
+		wantCalls int32
+	}{
+		{
+			name:      "coalesces concurrent identical requests",
+			enabled:   true,
+			wantCalls: 1,
+		},
+		{
+			name:      "sends every request when disabled",
+			enabled:   false,
+			wantCalls: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			release := make(chan struct{})
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				calls.Add(1)
+				<-release
+				return testutil.NewFileResponse(t, "test_data/retrieve_bot.json", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithSingleflight(tt.enabled))
+
+			var wg sync.WaitGroup
+			bots := make([]*recallaigo.Bot, 5)
+			for i := range bots {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					bot, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+					if err != nil {
+						t.Errorf("RetrieveBot() error = %v", err)
+					}
+					bots[i] = bot
+				}()
+			}
+
+			// Let every goroutine reach the transport or join the request in flight.
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("sent %d requests, want %d", got, tt.wantCalls)
+			}
+			for i, bot := range bots {
+				if bot == nil || bot.ID != bots[0].ID {
+					t.Errorf("bot %d = %+v, want the shared bot", i, bot)
+				}
+			}
+		})
+	}
+}
+
+func TestSingleflightCanceledLeader(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{}, 2)
+	c := &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+		if calls.Add(1) == 1 {
+			started <- struct{}{}
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+		return testutil.NewFileResponse(t, "test_data/retrieve_bot.json", http.StatusOK), nil
+	})}
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c), recallaigo.WithSingleflight(true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error)
+	go func() {
+		_, err := client.Bot.RetrieveBot(ctx, "some_id")
+		leader <- err
+	}()
+	<-started
+
+	follower := make(chan error)
+	go func() {
+		_, err := client.Bot.RetrieveBot(context.Background(), "some_id")
+		follower <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	if err := <-leader; err == nil {
+		t.Error("RetrieveBot() of the canceled caller succeeded, want an error")
+	}
+	if err := <-follower; err != nil {
+		t.Errorf("RetrieveBot() of the waiting caller error = %v", err)
+	}
+}
+
+// transportFunc is an http.RoundTripper that can fail like a real transport.
+type transportFunc func(req *http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,143 @@
+package recallaigo
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// partialSuffix marks files that are still being written or whose download failed.
+const partialSuffix = ".partial"
+
+// BlobSink stores downloaded content under a key.
+// Implementations can write to local disk or to object storage such as S3, GCS or Azure Blob Storage.
+// Put must consume r until io.EOF and must not keep the blob if reading r returns an error.
+type BlobSink interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+}
+
+// DirSink is a BlobSink that writes blobs as files into a directory on the local filesystem.
+// Keys may contain slashes to create subdirectories, but must stay inside the directory.
+//
+// Files are written to a temporary ".partial" file next to the destination and renamed once
+// complete, so a file at the destination path is never truncated.
+type DirSink struct {
+	Dir string
+	// Keep the ".partial" file of failed or cancelled downloads instead of removing it.
+	KeepPartial bool
+}
+
+func (d DirSink) Put(ctx context.Context, key string, r io.Reader) error {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return fmt.Errorf("invalid key: %s", key)
+	}
+
+	return writeFileAtomic(ctx, filepath.Join(d.Dir, filepath.FromSlash(key)), r, d.KeepPartial)
+}
+
+// TarSink is a BlobSink that writes blobs as files into a tar stream.
+// Blobs are buffered in a temporary file to learn their size before they are added to the stream.
+// It is safe for concurrent use. Close must be called to finish the stream.
+type TarSink struct {
+	mu sync.Mutex
+	tw *tar.Writer
+}
+
+// NewTarSink creates a TarSink writing to w.
+func NewTarSink(w io.Writer) *TarSink {
+	return &TarSink{tw: tar.NewWriter(w)}
+}
+
+func (s *TarSink) Put(ctx context.Context, key string, r io.Reader) error {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return fmt.Errorf("invalid key: %s", key)
+	}
+
+	f, err := os.CreateTemp("", "recallai-*"+partialSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := io.Copy(f, r)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind file: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	header := &tar.Header{
+		Name:    key,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: time.Now(),
+	}
+	if err := s.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write tar header: %w", err)
+	}
+	if _, err := io.Copy(s.tw, f); err != nil {
+		return fmt.Errorf("failed to write tar entry: %w", err)
+	}
+
+	return nil
+}
+
+// Close writes the end of the tar stream. It does not close the underlying writer.
+func (s *TarSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tw.Close()
+}
+
+// writeFileAtomic writes r to a temporary file and renames it to name once complete.
+// On failure or cancellation the temporary file is removed unless keepPartial is set.
+func writeFileAtomic(ctx context.Context, name string, r io.Reader, keepPartial bool) (err error) {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*"+partialSuffix)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer func() {
+		if err != nil && !keepPartial {
+			os.Remove(f.Name())
+		}
+	}()
+	if err := f.Chmod(0o644); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to set file mode: %w", err)
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+	// Do not publish a complete-looking file if the caller gave up in the meantime.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), name); err != nil {
+		return fmt.Errorf("failed to rename file: %w", err)
+	}
+
+	return nil
+}
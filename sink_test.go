@@ -0,0 +1,102 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestDownloadToSink(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		opts     recallaigo.DownloadOptions
+		wantErr  bool
+		wantFile bool
+	}{
+		{
+			name:     "stores blob",
+			key:      "bot/video.mp4",
+			wantFile: true,
+		},
+		{
+			name:    "discards blob failing verification",
+			key:     "bot/video.mp4",
+			opts:    recallaigo.DownloadOptions{ExpectedSize: 1},
+			wantErr: true,
+		},
+		{
+			name:    "rejects keys outside the directory",
+			key:     "../video.mp4",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testutil.NewTestClient(func(*http.Request) *http.Response {
+				return testutil.NewStringResponse("recording", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+			dir := t.TempDir()
+
+			err := client.Media.DownloadToSink(context.Background(), "https://media.test/video.mp4", recallaigo.DirSink{Dir: dir}, tt.key, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DownloadToSink() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			_, statErr := os.Stat(filepath.Join(dir, filepath.FromSlash(tt.key)))
+			if gotFile := statErr == nil; gotFile != tt.wantFile {
+				t.Errorf("DownloadToSink() file exists = %v, want %v", gotFile, tt.wantFile)
+			}
+		})
+	}
+}
+
+func TestDirSinkPartialFiles(t *testing.T) {
+	tests := []struct {
+		name        string
+		keepPartial bool
+		wantFiles   int
+	}{
+		{
+			name:      "removes partial file",
+			wantFiles: 0,
+		},
+		{
+			name:        "keeps marked partial file",
+			keepPartial: true,
+			wantFiles:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			sink := recallaigo.DirSink{Dir: dir, KeepPartial: tt.keepPartial}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			if err := sink.Put(ctx, "video.mp4", strings.NewReader("recording")); err == nil {
+				t.Fatal("Put() error = nil, want error for cancelled context")
+			}
+
+			if _, err := os.Stat(filepath.Join(dir, "video.mp4")); err == nil {
+				t.Error("Put() published the destination file")
+			}
+			partials, err := filepath.Glob(filepath.Join(dir, "video.mp4.*.partial"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(partials) != tt.wantFiles {
+				t.Errorf("Put() left %d partial files, want %d", len(partials), tt.wantFiles)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// TTSProvider synthesizes speech, e.g. with the API of a text-to-speech service.
+type TTSProvider interface {
+	// Synthesize returns the MP3 audio of the text, which the caller closes.
+	Synthesize(ctx context.Context, text string) (io.ReadCloser, error)
+}
+
+// TTSFunc adapts a function to a TTSProvider. Providers returning bytes can wrap them with
+// io.NopCloser(bytes.NewReader(mp3)).
+type TTSFunc func(ctx context.Context, text string) (io.ReadCloser, error)
+
+func (f TTSFunc) Synthesize(ctx context.Context, text string) (io.ReadCloser, error) {
+	return f(ctx, text)
+}
+
+// Speaker makes bots speak in their calls, with speech synthesized by a TTSProvider:
+//
+//	speaker := recallaigo.NewSpeaker(client.Bot, tts)
+//	err := speaker.Say(ctx, botID, "Hello")
+type Speaker struct {
+	bots BotService
+	tts  TTSProvider
+}
+
+// NewSpeaker creates a Speaker outputting the speech of tts with bots.
+func NewSpeaker(bots BotService, tts TTSProvider) *Speaker {
+	return &Speaker{bots: bots, tts: tts}
+}
+
+// Say synthesizes the text and outputs it as audio in the call of the bot. The audio is streamed
+// to the API while it is synthesized, so the request is not retried.
+func (s *Speaker) Say(ctx context.Context, botID, text string) error {
+	if text == "" {
+		return errors.New("text is required")
+	}
+
+	audio, err := s.tts.Synthesize(ctx, text)
+	if err != nil {
+		return fmt.Errorf("failed to synthesize speech: %w", err)
+	}
+	defer audio.Close()
+
+	_, err = s.bots.OutputAudio(ctx, botID, &OutputAudioRequest{Kind: OutputAudioKindMp3, Source: audio})
+	return err
+}
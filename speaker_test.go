@@ -0,0 +1,63 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestSpeaker(t *testing.T) {
+	synthesize := recallaigo.TTSFunc(func(ctx context.Context, text string) (io.ReadCloser, error) {
+		if text == "fail" {
+			return nil, errors.New("quota exceeded")
+		}
+		return io.NopCloser(strings.NewReader("mp3:" + text)), nil
+	})
+
+	tests := []struct {
+		name     string
+		text     string
+		wantData string
+		wantErr  bool
+	}{
+		{name: "outputs the speech", text: "Hello", wantData: "mp3:Hello"},
+		{name: "rejects empty text", text: "", wantErr: true},
+		{name: "returns errors of the provider", text: "fail", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got recallaigo.OutputAudioRequest
+			router := testutil.NewRouter()
+			router.Handle("POST /api/v1/bot/{id}/output_audio", func(req *http.Request) *http.Response {
+				json.NewDecoder(req.Body).Decode(&got)
+				return testutil.NewFileResponse(t, "test_data/output_audio.json", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+			err := recallaigo.NewSpeaker(client.Bot, synthesize).Say(context.Background(), "bot_id", tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Say() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if got.Kind != "" {
+					t.Error("Say() output audio despite the error")
+				}
+				return
+			}
+
+			data, _ := base64.StdEncoding.DecodeString(got.B64Data)
+			if got.Kind != recallaigo.OutputAudioKindMp3 || string(data) != tt.wantData {
+				t.Errorf("output %s audio %q, want mp3 audio %q", got.Kind, data, tt.wantData)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package recallaigo
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// SpeakingInterval is a stretch of time during which one participant was speaking.
+type SpeakingInterval struct {
+	Name   string
+	UserID int
+	// The times since the start of the recording at which the interval started and ended.
+	Start time.Duration
+	End   time.Duration
+}
+
+// Duration returns how long the participant was speaking.
+func (i SpeakingInterval) Duration() time.Duration {
+	return i.End - i.Start
+}
+
+// SpeakingIntervals converts the speaker timeline, which marks when each speaker started speaking,
+// into the intervals of the speakers in chronological order. Consecutive entries of the same speaker
+// are merged into one interval, which ends when another speaker starts or the timeline reports no
+// speaker. The interval of the last speaker ends at end, the length of the recording; it is dropped
+// if end is not after its start.
+func SpeakingIntervals(timeline []SpeakerTimelineEntry, end time.Duration) []SpeakingInterval {
+	timeline = slices.Clone(timeline)
+	slices.SortStableFunc(timeline, func(a, b SpeakerTimelineEntry) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	var (
+		intervals []SpeakingInterval
+		current   *SpeakingInterval
+	)
+	closeAt := func(at time.Duration) {
+		if current != nil && at > current.Start {
+			current.End = at
+			intervals = append(intervals, *current)
+		}
+		current = nil
+	}
+
+	for _, entry := range timeline {
+		if current != nil && current.Name == entry.Name && current.UserID == entry.UserID {
+			continue
+		}
+		closeAt(entry.Offset())
+		if entry.Name != "" {
+			current = &SpeakingInterval{Name: entry.Name, UserID: entry.UserID, Start: entry.Offset()}
+		}
+	}
+	closeAt(end)
+
+	return intervals
+}
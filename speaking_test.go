@@ -0,0 +1,77 @@
+package recallaigo_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestSpeakingIntervals(t *testing.T) {
+	const s = time.Second
+
+	tests := []struct {
+		name     string
+		timeline []recallaigo.SpeakerTimelineEntry
+		end      time.Duration
+		want     []recallaigo.SpeakingInterval
+	}{
+		{
+			name: "merges consecutive entries of a speaker",
+			timeline: []recallaigo.SpeakerTimelineEntry{
+				{Name: "Alice", UserID: 1, Timestamp: 0},
+				{Name: "Alice", UserID: 1, Timestamp: 2},
+				{Name: "Bob", UserID: 2, Timestamp: 5},
+				{Name: "Alice", UserID: 1, Timestamp: 7.5},
+			},
+			end: 10 * s,
+			want: []recallaigo.SpeakingInterval{
+				{Name: "Alice", UserID: 1, Start: 0, End: 5 * s},
+				{Name: "Bob", UserID: 2, Start: 5 * s, End: 7500 * time.Millisecond},
+				{Name: "Alice", UserID: 1, Start: 7500 * time.Millisecond, End: 10 * s},
+			},
+		},
+		{
+			name: "ends intervals at entries without speaker",
+			timeline: []recallaigo.SpeakerTimelineEntry{
+				{Name: "Alice", UserID: 1, Timestamp: 1},
+				{Timestamp: 3},
+				{Name: "Alice", UserID: 1, Timestamp: 4},
+			},
+			end: 6 * s,
+			want: []recallaigo.SpeakingInterval{
+				{Name: "Alice", UserID: 1, Start: 1 * s, End: 3 * s},
+				{Name: "Alice", UserID: 1, Start: 4 * s, End: 6 * s},
+			},
+		},
+		{
+			name: "sorts the timeline and drops the last interval without end",
+			timeline: []recallaigo.SpeakerTimelineEntry{
+				{Name: "Bob", UserID: 2, Timestamp: 4},
+				{Name: "Alice", UserID: 1, Timestamp: 1},
+			},
+			want: []recallaigo.SpeakingInterval{
+				{Name: "Alice", UserID: 1, Start: 1 * s, End: 4 * s},
+			},
+		},
+		{
+			name: "empty",
+			end:  time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := recallaigo.SpeakingIntervals(tt.timeline, tt.end)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SpeakingIntervals() = %+v, want %+v", got, tt.want)
+			}
+			for _, interval := range got {
+				if interval.Duration() != interval.End-interval.Start || interval.Duration() <= 0 {
+					t.Errorf("Duration() of %+v = %v", interval, interval.Duration())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package recallaigo
+
+// SubCode gives the reason for a status change, e.g. why a bot failed or why the call ended.
+// see https://docs.recall.ai/docs/sub-codes
+type SubCode string
+
+// Sub codes of the call_ended status.
+const (
+	SubCodeCallEndedByHost                     SubCode = "call_ended_by_host"
+	SubCodeCallEndedByPlatformIdle             SubCode = "call_ended_by_platform_idle"
+	SubCodeCallEndedByPlatformMaxLength        SubCode = "call_ended_by_platform_max_length"
+	SubCodeCallEndedByPlatformWaitingRoom      SubCode = "call_ended_by_platform_waiting_room_timeout"
+	SubCodeTimeoutExceededWaitingRoom          SubCode = "timeout_exceeded_waiting_room"
+	SubCodeTimeoutExceededNooneJoined          SubCode = "timeout_exceeded_noone_joined"
+	SubCodeTimeoutExceededEveryoneLeft         SubCode = "timeout_exceeded_everyone_left"
+	SubCodeTimeoutExceededSilenceDetected      SubCode = "timeout_exceeded_silence_detected"
+	SubCodeTimeoutExceededOnlyBotsByNames      SubCode = "timeout_exceeded_only_bots_detected_using_participant_names"
+	SubCodeTimeoutExceededOnlyBotsByEvents     SubCode = "timeout_exceeded_only_bots_detected_using_participant_events"
+	SubCodeTimeoutExceededInCallNotRecording   SubCode = "timeout_exceeded_in_call_not_recording"
+	SubCodeTimeoutExceededInCallRecording      SubCode = "timeout_exceeded_in_call_recording"
+	SubCodeTimeoutExceededPermissionDenied     SubCode = "timeout_exceeded_recording_permission_denied"
+	SubCodeTimeoutExceededMaxDuration          SubCode = "timeout_exceeded_max_duration"
+	SubCodeBotKickedFromCall                   SubCode = "bot_kicked_from_call"
+	SubCodeBotKickedFromWaitingRoom            SubCode = "bot_kicked_from_waiting_room"
+	SubCodeBotReceivedLeaveCall                SubCode = "bot_received_leave_call"
+	SubCodeMeetingEnded                        SubCode = "meeting_ended"
+	SubCodeRecordingPermissionDeniedByHost     SubCode = "zoom_local_recording_request_denied_by_host"
+	SubCodeZoomLocalRecordingDisabled          SubCode = "zoom_local_recording_disabled"
+	SubCodeZoomLocalRecordingRequestDisabled   SubCode = "zoom_local_recording_request_disabled"
+	SubCodeZoomLocalRecordingGrantNotSupported SubCode = "zoom_local_recording_grant_not_supported"
+)
+
+// Sub codes of the in-call statuses the bot changes to when it moves between breakout rooms.
+const (
+	SubCodeBotJoinedBreakoutRoom SubCode = "bot_joined_breakout_room"
+	SubCodeBotLeftBreakoutRoom   SubCode = "bot_left_breakout_room"
+)
+
+// Sub codes of the fatal status.
+const (
+	SubCodeBotErrored                       SubCode = "bot_errored"
+	SubCodeMeetingNotFound                  SubCode = "meeting_not_found"
+	SubCodeMeetingNotStarted                SubCode = "meeting_not_started"
+	SubCodeMeetingRequiresRegistration      SubCode = "meeting_requires_registration"
+	SubCodeMeetingRequiresSignIn            SubCode = "meeting_requires_sign_in"
+	SubCodeMeetingLinkExpired               SubCode = "meeting_link_expired"
+	SubCodeMeetingLinkInvalid               SubCode = "meeting_link_invalid"
+	SubCodeMeetingPasswordIncorrect         SubCode = "meeting_password_incorrect"
+	SubCodeMeetingLocked                    SubCode = "meeting_locked"
+	SubCodeMeetingFull                      SubCode = "meeting_full"
+	SubCodeZoomSDKCredentialsMissing        SubCode = "zoom_sdk_credentials_missing"
+	SubCodeZoomSDKUpdateRequired            SubCode = "zoom_sdk_update_required"
+	SubCodeZoomSDKAppNotPublished           SubCode = "zoom_sdk_app_not_published"
+	SubCodeZoomInvalidSignature             SubCode = "zoom_invalid_signature"
+	SubCodeZoomEmailRequired                SubCode = "zoom_email_required"
+	SubCodeZoomCaptchaRequired              SubCode = "zoom_captcha_required"
+	SubCodeZoomAccountBlocked               SubCode = "zoom_account_blocked"
+	SubCodeZoomWebDisallowed                SubCode = "zoom_web_disallowed"
+	SubCodeZoomConnectionFailed             SubCode = "zoom_connection_failed"
+	SubCodeZoomInternalError                SubCode = "zoom_internal_error"
+	SubCodeGoogleMeetInternalError          SubCode = "google_meet_internal_error"
+	SubCodeGoogleMeetSignInFailed           SubCode = "google_meet_sign_in_failed"
+	SubCodeGoogleMeetLoginRequired          SubCode = "google_meet_login_required"
+	SubCodeGoogleMeetBotBlocked             SubCode = "google_meet_bot_blocked"
+	SubCodeMicrosoftTeamsInternalError      SubCode = "microsoft_teams_internal_error"
+	SubCodeMicrosoftTeamsCallDropped        SubCode = "microsoft_teams_call_dropped"
+	SubCodeMicrosoftTeamsSignInCredsMissing SubCode = "microsoft_teams_sign_in_credentials_missing"
+	SubCodeMicrosoftTeams2FARequired        SubCode = "microsoft_teams_2fa_required"
+)
+
+// SubCodeInfo explains a sub code.
+type SubCodeInfo struct {
+	// A human readable explanation of the condition.
+	Explanation string
+	// Whether a new bot for the same meeting is likely to succeed, e.g. after a transient platform error.
+	// Conditions caused by the meeting or the configuration, such as a wrong password, are not retryable,
+	// nor are those that only clear up after a while, such as a meeting that has not started yet or is
+	// full, since a bot retried right away would hit them again.
+	Retryable bool
+}
+
+var subCodeInfos = map[SubCode]SubCodeInfo{
+	SubCodeCallEndedByHost:                     {Explanation: "The host ended the meeting."},
+	SubCodeCallEndedByPlatformIdle:             {Explanation: "The platform ended the meeting because it was idle."},
+	SubCodeCallEndedByPlatformMaxLength:        {Explanation: "The platform ended the meeting because it reached its maximum length."},
+	SubCodeCallEndedByPlatformWaitingRoom:      {Explanation: "The platform removed the bot after it waited too long in the waiting room."},
+	SubCodeTimeoutExceededWaitingRoom:          {Explanation: "The bot left because it was not admitted from the waiting room in time."},
+	SubCodeTimeoutExceededNooneJoined:          {Explanation: "The bot left because no one else joined the meeting."},
+	SubCodeTimeoutExceededEveryoneLeft:         {Explanation: "The bot left because everyone else left the meeting."},
+	SubCodeTimeoutExceededSilenceDetected:      {Explanation: "The bot left because the meeting was silent for too long."},
+	SubCodeTimeoutExceededOnlyBotsByNames:      {Explanation: "The bot left because only other bots remained, detected by participant names."},
+	SubCodeTimeoutExceededOnlyBotsByEvents:     {Explanation: "The bot left because only other bots remained, detected by participant events."},
+	SubCodeTimeoutExceededInCallNotRecording:   {Explanation: "The bot left because it was in the call without recording for too long."},
+	SubCodeTimeoutExceededInCallRecording:      {Explanation: "The bot left because it was recording for longer than allowed."},
+	SubCodeTimeoutExceededPermissionDenied:     {Explanation: "The bot left because recording permission stayed denied for too long."},
+	SubCodeTimeoutExceededMaxDuration:          {Explanation: "The bot left because it reached its maximum duration."},
+	SubCodeBotKickedFromCall:                   {Explanation: "A participant removed the bot from the call."},
+	SubCodeBotKickedFromWaitingRoom:            {Explanation: "A participant removed the bot from the waiting room."},
+	SubCodeBotReceivedLeaveCall:                {Explanation: "The bot was asked to leave the call through the API."},
+	SubCodeMeetingEnded:                        {Explanation: "The meeting ended."},
+	SubCodeRecordingPermissionDeniedByHost:     {Explanation: "The host denied the request to record."},
+	SubCodeZoomLocalRecordingDisabled:          {Explanation: "Local recording is disabled for the Zoom account of the host."},
+	SubCodeZoomLocalRecordingRequestDisabled:   {Explanation: "Participants are not allowed to request local recording in this Zoom meeting."},
+	SubCodeZoomLocalRecordingGrantNotSupported: {Explanation: "The Zoom client of the host cannot grant local recording permission."},
+
+	SubCodeBotJoinedBreakoutRoom: {Explanation: "The bot moved from the main room to a breakout room."},
+	SubCodeBotLeftBreakoutRoom:   {Explanation: "The bot returned from a breakout room to the main room."},
+
+	SubCodeBotErrored:                       {Explanation: "The bot hit an unexpected error.", Retryable: true},
+	SubCodeMeetingNotFound:                  {Explanation: "The meeting does not exist."},
+	SubCodeMeetingNotStarted:                {Explanation: "The meeting has not started yet."},
+	SubCodeMeetingRequiresRegistration:      {Explanation: "The meeting requires participants to register."},
+	SubCodeMeetingRequiresSignIn:            {Explanation: "The meeting only admits signed in participants."},
+	SubCodeMeetingLinkExpired:               {Explanation: "The meeting link has expired."},
+	SubCodeMeetingLinkInvalid:               {Explanation: "The meeting link is invalid."},
+	SubCodeMeetingPasswordIncorrect:         {Explanation: "The meeting password is incorrect."},
+	SubCodeMeetingLocked:                    {Explanation: "The meeting is locked."},
+	SubCodeMeetingFull:                      {Explanation: "The meeting has reached its participant limit."},
+	SubCodeZoomSDKCredentialsMissing:        {Explanation: "No Zoom SDK credentials are configured for the Recall.ai workspace."},
+	SubCodeZoomSDKUpdateRequired:            {Explanation: "The meeting requires a newer Zoom SDK version."},
+	SubCodeZoomSDKAppNotPublished:           {Explanation: "The Zoom app is not published, so it can only join meetings of its own account."},
+	SubCodeZoomInvalidSignature:             {Explanation: "The Zoom SDK credentials are invalid."},
+	SubCodeZoomEmailRequired:                {Explanation: "The Zoom meeting requires participants to provide an email address."},
+	SubCodeZoomCaptchaRequired:              {Explanation: "Zoom asked the bot to solve a captcha."},
+	SubCodeZoomAccountBlocked:               {Explanation: "The Zoom account used by the bot is blocked."},
+	SubCodeZoomWebDisallowed:                {Explanation: "The Zoom meeting does not allow joining from the web client."},
+	SubCodeZoomConnectionFailed:             {Explanation: "The bot could not connect to Zoom.", Retryable: true},
+	SubCodeZoomInternalError:                {Explanation: "Zoom returned an internal error.", Retryable: true},
+	SubCodeGoogleMeetInternalError:          {Explanation: "Google Meet returned an internal error.", Retryable: true},
+	SubCodeGoogleMeetSignInFailed:           {Explanation: "The bot could not sign in to its Google account.", Retryable: true},
+	SubCodeGoogleMeetLoginRequired:          {Explanation: "The Google Meet meeting only admits signed in participants."},
+	SubCodeGoogleMeetBotBlocked:             {Explanation: "Google Meet blocked the bot from joining."},
+	SubCodeMicrosoftTeamsInternalError:      {Explanation: "Microsoft Teams returned an internal error.", Retryable: true},
+	SubCodeMicrosoftTeamsCallDropped:        {Explanation: "Microsoft Teams dropped the call.", Retryable: true},
+	SubCodeMicrosoftTeamsSignInCredsMissing: {Explanation: "The meeting requires a signed in bot but no Microsoft credentials are configured."},
+	SubCodeMicrosoftTeams2FARequired:        {Explanation: "The Microsoft account of the bot requires two-factor authentication."},
+}
+
+// ExplainSubCode returns the explanation of a documented sub code.
+// The second return value is false for unknown sub codes.
+func ExplainSubCode(code SubCode) (SubCodeInfo, bool) {
+	info, ok := subCodeInfos[code]
+	return info, ok
+}
+
+func (c SubCode) String() string {
+	return string(c)
+}
+
+// Explanation returns a human readable explanation of the sub code, or the sub code itself if it is unknown.
+func (c SubCode) Explanation() string {
+	if info, ok := subCodeInfos[c]; ok {
+		return info.Explanation
+	}
+	return string(c)
+}
+
+// IsRetryable reports whether a new bot for the same meeting is likely to succeed. Unknown sub codes are not retryable.
+func (c SubCode) IsRetryable() bool {
+	return subCodeInfos[c].Retryable
+}
+
+var statusExplanations = map[Status]string{
+	StatusReady:                      "The bot is ready to join the call.",
+	StatusJoiningCall:                "The bot is joining the call.",
+	StatusInWaitingRoom:              "The bot is waiting to be admitted to the call.",
+	StatusInCallNotRecording:         "The bot is in the call but not recording.",
+	StatusRecordingPermissionAllowed: "The host allowed the bot to record.",
+	StatusRecordingPermissionDenied:  "The host denied the bot permission to record.",
+	StatusInCallRecording:            "The bot is in the call and recording.",
+	StatusRecordingDone:              "The bot stopped recording.",
+	StatusCallEnded:                  "The bot left the call.",
+	StatusDone:                       "The recording is processed and available.",
+	StatusFatal:                      "The bot failed.",
+	StatusMediaExpired:               "The recording was deleted after its retention period.",
+	StatusAnalysisDone:               "The analysis of the recording finished.",
+	StatusAnalysisFailed:             "The analysis of the recording failed.",
+}
+
+// Explanation returns a human readable explanation of the status, or the status itself if it is unknown.
+func (s Status) Explanation() string {
+	if explanation, ok := statusExplanations[s]; ok {
+		return explanation
+	}
+	return string(s)
+}
+
+// Explanation describes the status change, including its sub code if there is one,
+// e.g. "The bot failed. The meeting password is incorrect.".
+func (c StatusChange) Explanation() string {
+	if c.SubCode == "" {
+		return c.Code.Explanation()
+	}
+	return c.Code.Explanation() + " " + c.SubCode.Explanation()
+}
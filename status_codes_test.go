@@ -0,0 +1,52 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestSubCode(t *testing.T) {
+	tests := []struct {
+		code          recallaigo.SubCode
+		wantKnown     bool
+		wantRetryable bool
+	}{
+		{code: recallaigo.SubCodeBotErrored, wantKnown: true, wantRetryable: true},
+		{code: recallaigo.SubCodeZoomInternalError, wantKnown: true, wantRetryable: true},
+		{code: recallaigo.SubCodeMeetingPasswordIncorrect, wantKnown: true},
+		{code: recallaigo.SubCodeMeetingNotStarted, wantKnown: true},
+		{code: recallaigo.SubCodeMeetingFull, wantKnown: true},
+		{code: recallaigo.SubCodeZoomCaptchaRequired, wantKnown: true},
+		{code: recallaigo.SubCodeBotReceivedLeaveCall, wantKnown: true},
+		{code: recallaigo.SubCodeZoomSDKCredentialsMissing, wantKnown: true},
+		{code: "something_new"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			info, ok := recallaigo.ExplainSubCode(tt.code)
+			if ok != tt.wantKnown {
+				t.Errorf("ExplainSubCode() ok = %v, want %v", ok, tt.wantKnown)
+			}
+			if ok && info.Explanation == "" {
+				t.Error("ExplainSubCode() returned no explanation")
+			}
+			if got := tt.code.IsRetryable(); got != tt.wantRetryable {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.wantRetryable)
+			}
+			if !ok && tt.code.Explanation() != tt.code.String() {
+				t.Errorf("Explanation() = %q, want the sub code", tt.code.Explanation())
+			}
+		})
+	}
+}
+
+func TestStatusChangeExplanation(t *testing.T) {
+	change := recallaigo.StatusChange{Code: recallaigo.StatusFatal, SubCode: recallaigo.SubCodeMeetingPasswordIncorrect}
+
+	want := "The bot failed. The meeting password is incorrect."
+	if got := change.Explanation(); got != want {
+		t.Errorf("Explanation() = %q, want %q", got, want)
+	}
+}
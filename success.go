@@ -0,0 +1,42 @@
+package recallaigo
+
+import (
+	"context"
+	"slices"
+)
+
+type (
+	successStatusKey struct{}
+	bodilessKey      struct{}
+)
+
+// WithSuccessStatus returns a context whose requests to bodiless endpoints, such as
+// DeleteScheduledBot or StopOutputAudio, succeed with exactly the given HTTP status codes instead
+// of any 2xx status. Responses with other status codes fail with an *Error. E.g. a deletion racing
+// with another one can accept 404 Not Found:
+//
+//	ctx = recallaigo.WithSuccessStatus(ctx, http.StatusNoContent, http.StatusNotFound)
+//	err := client.Bot.DeleteScheduledBot(ctx, botID)
+//
+// Endpoints whose response body is decoded ignore the override, as an error body cannot be
+// decoded as a result. Since the override travels with the context, it also applies to the
+// bodiless requests made by helpers given the context, e.g. the deletions of a DataDeleter;
+// derive the context only for the call it is meant for.
+func WithSuccessStatus(ctx context.Context, codes ...int) context.Context {
+	return context.WithValue(ctx, successStatusKey{}, slices.Clone(codes))
+}
+
+// bodiless marks ctx as the context of a request whose response body is not decoded, which
+// honors WithSuccessStatus.
+func bodiless(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bodilessKey{}, true)
+}
+
+// isSuccess reports whether a response with the status code is successful for a request made
+// with ctx: any 2xx status, unless overridden with WithSuccessStatus for a bodiless request.
+func isSuccess(ctx context.Context, statusCode int) bool {
+	if codes, ok := ctx.Value(successStatusKey{}).([]int); ok && ctx.Value(bodilessKey{}) != nil {
+		return slices.Contains(codes, statusCode)
+	}
+	return statusCode >= 200 && statusCode < 300
+}
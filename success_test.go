@@ -0,0 +1,93 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestSuccessStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		success    []int
+		wantStatus int
+	}{
+		{
+			name:       "no content",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "ok",
+			statusCode: http.StatusOK,
+			body:       `{}`,
+		},
+		{
+			name:       "accepted",
+			statusCode: http.StatusAccepted,
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"detail": "Not found."}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "not found accepted by override",
+			statusCode: http.StatusNotFound,
+			body:       `{"detail": "Not found."}`,
+			success:    []int{http.StatusNoContent, http.StatusNotFound},
+		},
+		{
+			name:       "ok rejected by override",
+			statusCode: http.StatusOK,
+			body:       `{}`,
+			success:    []int{http.StatusNoContent},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := testutil.NewRouter()
+			router.HandleString("DELETE /api/v1/bot/bot_id", tt.body, tt.statusCode)
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+			ctx := context.Background()
+			if tt.success != nil {
+				ctx = recallaigo.WithSuccessStatus(ctx, tt.success...)
+			}
+			err := client.Bot.DeleteScheduledBot(ctx, "bot_id")
+
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Errorf("DeleteScheduledBot() error = %v", err)
+				}
+				return
+			}
+			var apiErr *recallaigo.Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.wantStatus {
+				t.Errorf("DeleteScheduledBot() error = %v, want an *Error with status %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestSuccessStatusIgnoredByDecodedEndpoints(t *testing.T) {
+	router := testutil.NewRouter()
+	router.HandleString("GET /api/v1/bot/bot_id", `{"detail": "Not found."}`, http.StatusNotFound)
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+	ctx := recallaigo.WithSuccessStatus(context.Background(), http.StatusNotFound)
+	_, err := client.Bot.RetrieveBot(ctx, "bot_id")
+
+	var apiErr *recallaigo.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("RetrieveBot() error = %v, want an *Error with status %d", err, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,142 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrReplacementBudgetExhausted is returned by ReplacementSupervisor.Watch when a bot failed
+// with a retryable error but no replacement attempts are left.
+var ErrReplacementBudgetExhausted = errors.New("replacement attempts exhausted")
+
+// ReplacementEvent describes a replacement bot created by the ReplacementSupervisor.
+type ReplacementEvent struct {
+	// The ID of the bot the supervisor was originally asked to watch.
+	OriginalBotID string
+	// The ID of the bot that failed.
+	FailedBotID string
+	// The ID of the newly created bot.
+	ReplacementBotID string
+	// The sub code of the fatal status change that triggered the replacement.
+	SubCode SubCode
+	// The replacement attempt number, starting at 1.
+	Attempt int
+}
+
+// ReplacementOptions configures a ReplacementSupervisor.
+type ReplacementOptions struct {
+	// The maximum number of replacement bots created per watched bot. Defaults to 3.
+	MaxAttempts int
+	// The interval between status polls. Defaults to 10 seconds.
+	PollInterval time.Duration
+	// The fatal sub codes that trigger a replacement. Defaults to the sub codes for which
+	// SubCode.IsRetryable reports true.
+	RetryableSubCodes []SubCode
+	// Called after each replacement bot has been created.
+	OnReplace func(event ReplacementEvent)
+}
+
+// ReplacementSupervisor watches bots and creates a replacement with the same configuration
+// when a bot fails with a retryable error before it started recording.
+type ReplacementSupervisor struct {
+	bots      BotService
+	opts      ReplacementOptions
+	retryable map[SubCode]bool
+}
+
+// NewReplacementSupervisor creates a supervisor that uses the given bot service.
+func NewReplacementSupervisor(bots BotService, opts ReplacementOptions) *ReplacementSupervisor {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+
+	var retryable map[SubCode]bool
+	if opts.RetryableSubCodes != nil {
+		retryable = make(map[SubCode]bool, len(opts.RetryableSubCodes))
+		for _, code := range opts.RetryableSubCodes {
+			retryable[code] = true
+		}
+	}
+
+	return &ReplacementSupervisor{
+		bots:      bots,
+		opts:      opts,
+		retryable: retryable,
+	}
+}
+
+// Watch blocks until the bot starts recording or reaches a terminal status, replacing it with a
+// new bot created from request whenever it fails with a retryable sub code before recording.
+// If request is nil, the configuration is cloned from the watched bot with CloneBotConfig.
+// It returns the last bot that was watched.
+func (s *ReplacementSupervisor) Watch(ctx context.Context, botID string, request *CreateBotRequest) (*Bot, error) {
+	if request == nil {
+		bot, err := s.bots.RetrieveBot(ctx, botID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve bot configuration: %w", err)
+		}
+		request = CloneBotConfig(bot)
+		if err := request.Validate(); err != nil {
+			return nil, fmt.Errorf("failed to clone bot configuration: %w", err)
+		}
+	}
+
+	currentID := botID
+	for attempt := 1; ; attempt++ {
+		bot, err := s.bots.WaitForStatus(ctx, currentID, s.opts.PollInterval, StatusInCallRecording, StatusFatal)
+		if errors.Is(err, ErrTerminalStatus) {
+			return bot, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch bot %s: %w", currentID, err)
+		}
+
+		if !s.shouldReplace(bot) {
+			return bot, nil
+		}
+		if attempt > s.opts.MaxAttempts {
+			return bot, ErrReplacementBudgetExhausted
+		}
+
+		replacement, err := s.bots.CreateBot(ctx, request)
+		if err != nil {
+			return bot, fmt.Errorf("failed to create replacement bot: %w", err)
+		}
+
+		if s.opts.OnReplace != nil {
+			s.opts.OnReplace(ReplacementEvent{
+				OriginalBotID:    botID,
+				FailedBotID:      bot.ID,
+				ReplacementBotID: replacement.ID,
+				SubCode:          bot.LatestStatusChange().SubCode,
+				Attempt:          attempt,
+			})
+		}
+		currentID = replacement.ID
+	}
+}
+
+// shouldReplace reports whether the bot failed with a retryable sub code before it started recording.
+func (s *ReplacementSupervisor) shouldReplace(bot *Bot) bool {
+	if bot.CurrentStatus() != StatusFatal {
+		return false
+	}
+	if len(bot.Recordings) > 0 {
+		return false
+	}
+	for _, change := range bot.StatusChanges {
+		if change.Code == StatusInCallRecording {
+			return false
+		}
+	}
+	subCode := bot.LatestStatusChange().SubCode
+	if s.retryable == nil {
+		return subCode.IsRetryable()
+	}
+	return s.retryable[subCode]
+}
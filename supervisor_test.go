@@ -0,0 +1,121 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// fakeBotService serves bots from memory. Methods that are not overridden panic.
+type fakeBotService struct {
+	recallaigo.BotService
+	bots    map[string]*recallaigo.Bot
+	created int
+	// next is returned for every created bot, with a generated ID.
+	next recallaigo.Bot
+}
+
+func (f *fakeBotService) WaitForStatus(ctx context.Context, botID string, interval time.Duration, statuses ...recallaigo.Status) (*recallaigo.Bot, error) {
+	bot, ok := f.bots[botID]
+	if !ok {
+		return nil, fmt.Errorf("bot %s not found", botID)
+	}
+	return bot, nil
+}
+
+func (f *fakeBotService) CreateBot(ctx context.Context, request *recallaigo.CreateBotRequest) (*recallaigo.Bot, error) {
+	f.created++
+	bot := f.next
+	bot.ID = fmt.Sprintf("replacement-%d", f.created)
+	f.bots[bot.ID] = &bot
+	return &bot, nil
+}
+
+func fatalBot(id string, subCode recallaigo.SubCode) *recallaigo.Bot {
+	return &recallaigo.Bot{
+		ID: id,
+		StatusChanges: []recallaigo.StatusChange{
+			{Code: "joining_call"},
+			{Code: "fatal", SubCode: subCode},
+		},
+	}
+}
+
+func TestReplacementSupervisor(t *testing.T) {
+	request := &recallaigo.CreateBotRequest{MeetingURL: "https://test.com", BotName: "Test Bot"}
+
+	tests := []struct {
+		name        string
+		bot         *recallaigo.Bot
+		next        recallaigo.Bot
+		maxAttempts int
+		retryable   []recallaigo.SubCode
+		wantCreated int
+		wantErr     error
+	}{
+		{
+			name:        "replaces retryable failure",
+			bot:         fatalBot("bot", "bot_errored"),
+			next:        recallaigo.Bot{StatusChanges: []recallaigo.StatusChange{{Code: "in_call_recording"}}},
+			wantCreated: 1,
+		},
+		{
+			name:        "ignores non-retryable failure",
+			bot:         fatalBot("bot", "meeting_not_found"),
+			wantCreated: 0,
+		},
+		{
+			name:        "ignores bots removed through the API",
+			bot:         fatalBot("bot", recallaigo.SubCodeBotReceivedLeaveCall),
+			wantCreated: 0,
+		},
+		{
+			name:        "uses custom retryable sub codes",
+			bot:         fatalBot("bot", "meeting_not_found"),
+			next:        recallaigo.Bot{StatusChanges: []recallaigo.StatusChange{{Code: "in_call_recording"}}},
+			retryable:   []recallaigo.SubCode{recallaigo.SubCodeMeetingNotFound},
+			wantCreated: 1,
+		},
+		{
+			name:        "stops when budget is exhausted",
+			bot:         fatalBot("bot", "bot_errored"),
+			next:        *fatalBot("", "bot_errored"),
+			maxAttempts: 2,
+			wantCreated: 2,
+			wantErr:     recallaigo.ErrReplacementBudgetExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bots := &fakeBotService{
+				bots: map[string]*recallaigo.Bot{tt.bot.ID: tt.bot},
+				next: tt.next,
+			}
+
+			var events []recallaigo.ReplacementEvent
+			supervisor := recallaigo.NewReplacementSupervisor(bots, recallaigo.ReplacementOptions{
+				MaxAttempts:       tt.maxAttempts,
+				RetryableSubCodes: tt.retryable,
+				OnReplace: func(event recallaigo.ReplacementEvent) {
+					events = append(events, event)
+				},
+			})
+
+			_, err := supervisor.Watch(context.Background(), tt.bot.ID, request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Watch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if bots.created != tt.wantCreated {
+				t.Errorf("Watch() created %d bots, want %d", bots.created, tt.wantCreated)
+			}
+			if len(events) != tt.wantCreated {
+				t.Errorf("Watch() reported %d replacements, want %d", len(events), tt.wantCreated)
+			}
+		})
+	}
+}
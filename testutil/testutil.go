@@ -0,0 +1,165 @@
+// Package testutil provides HTTP transport stubs for unit testing code that uses recallaigo
+// without a server. Pass the returned clients to recallaigo.WithHTTPClient:
+//
+//	router := testutil.NewRouter()
+//	router.HandleFile("GET /api/v1/bot/{id}", "test_data/retrieve_bot.json", http.StatusOK)
+//	client := recallaigo.NewClient("token", recallaigo.WithHTTPClient(router.Client()))
+//
+// Code depending on the recallaigo.Recall interface can be given a FakeRecall instead of a client.
+//
+// For tests against a fake API server with realistic default responses, see the recalltest package.
+package testutil
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// RoundTripFunc is an http.RoundTripper answering every request with the returned response.
+type RoundTripFunc func(req *http.Request) *http.Response
+
+// RoundTrip implements http.RoundTripper.
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+// NewTestClient returns *http.Client with Transport replaced to avoid making real calls
+func NewTestClient(fn RoundTripFunc) *http.Client {
+	return &http.Client{
+		Transport: fn,
+	}
+}
+
+// NewMockedClient returns *http.Client which responds with content from given file
+func NewMockedClient(t testing.TB, requestMockFile string, statusCode int) *http.Client {
+	return NewTestClient(func(*http.Request) *http.Response {
+		return NewFileResponse(t, requestMockFile, statusCode)
+	})
+}
+
+// NewFileResponse returns *http.Response with content from given file
+func NewFileResponse(t testing.TB, requestMockFile string, statusCode int) *http.Response {
+	b, err := os.Open(requestMockFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       b,
+		Header:     make(http.Header),
+	}
+}
+
+// NewStringResponse returns *http.Response with the given content
+func NewStringResponse(content string, statusCode int) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(content)),
+		Header:     make(http.Header),
+	}
+}
+
+// Router is an http.RoundTripper dispatching requests by method and path, with the patterns of
+// http.ServeMux, e.g. "GET /api/v1/bot/{id}". Handlers can read the wildcards with req.PathValue.
+// Requests matching no route get a 404 Not Found response. It is safe for concurrent use.
+type Router struct {
+	mux *http.ServeMux
+}
+
+// NewRouter creates a router without routes.
+func NewRouter() *Router {
+	return &Router{mux: http.NewServeMux()}
+}
+
+// Handle answers the requests matching the pattern with fn. Like http.ServeMux, it panics
+// if the pattern is invalid or already registered.
+func (r *Router) Handle(pattern string, fn RoundTripFunc) {
+	r.mux.HandleFunc(pattern, func(w http.ResponseWriter, req *http.Request) {
+		w.(*capturedResponse).res = fn(req)
+	})
+}
+
+// HandleFile answers the requests matching the pattern with the content of a file.
+// It panics if the file cannot be read.
+func (r *Router) HandleFile(pattern string, requestMockFile string, statusCode int) {
+	data, err := os.ReadFile(requestMockFile)
+	if err != nil {
+		panic(err)
+	}
+	r.HandleString(pattern, string(data), statusCode)
+}
+
+// HandleString answers the requests matching the pattern with the given content.
+func (r *Router) HandleString(pattern string, content string, statusCode int) {
+	r.Handle(pattern, func(*http.Request) *http.Response {
+		return NewStringResponse(content, statusCode)
+	})
+}
+
+// Client returns *http.Client sending its requests to the router.
+func (r *Router) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Router) RoundTrip(req *http.Request) (*http.Response, error) {
+	w := &capturedResponse{header: make(http.Header)}
+	r.mux.ServeHTTP(w, req)
+
+	res := w.res
+	if res == nil {
+		res = NewStringResponse(`{"detail": "Not found."}`, http.StatusNotFound)
+	}
+	if res.Request == nil {
+		res.Request = req
+	}
+	return res, nil
+}
+
+// capturedResponse is the http.ResponseWriter passed through the mux of a Router. Route handlers
+// store their response in it, and anything written by the mux itself, e.g. a 404 page, is dropped.
+type capturedResponse struct {
+	header http.Header
+	res    *http.Response
+}
+
+func (w *capturedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *capturedResponse) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *capturedResponse) WriteHeader(int) {}
+
+// FakeRecall is a recallaigo.Recall returning the given services, typically fakes embedding
+// the service interface and overriding the methods under test:
+//
+//	type fakeBots struct{ recallaigo.BotService }
+//
+//	func (fakeBots) RetrieveBot(ctx context.Context, botID string) (*recallaigo.Bot, error) {
+//		return &recallaigo.Bot{ID: botID}, nil
+//	}
+//
+//	recall := &testutil.FakeRecall{Bot: fakeBots{}}
+type FakeRecall struct {
+	Bot   recallaigo.BotService
+	Media recallaigo.MediaService
+}
+
+var _ recallaigo.Recall = (*FakeRecall)(nil)
+
+func (f *FakeRecall) BotService() recallaigo.BotService {
+	return f.Bot
+}
+
+func (f *FakeRecall) MediaService() recallaigo.MediaService {
+	return f.Media
+}
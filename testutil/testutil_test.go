@@ -0,0 +1,71 @@
+package testutil_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestRouter(t *testing.T) {
+	router := testutil.NewRouter()
+	router.HandleFile("GET /api/v1/bot/{id}", "../test_data/retrieve_bot.json", http.StatusOK)
+	router.HandleString("DELETE /api/v1/bot/{id}", "", http.StatusNoContent)
+
+	var left string
+	router.Handle("POST /api/v1/bot/{id}/leave_call", func(req *http.Request) *http.Response {
+		left = req.PathValue("id")
+		return testutil.NewStringResponse(`{"id": "left"}`, http.StatusOK)
+	})
+
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+	ctx := context.Background()
+
+	if _, err := client.Bot.RetrieveBot(ctx, "some_id"); err != nil {
+		t.Errorf("RetrieveBot() error = %v", err)
+	}
+	if err := client.Bot.DeleteScheduledBot(ctx, "some_id"); err != nil {
+		t.Errorf("DeleteScheduledBot() error = %v", err)
+	}
+	if _, err := client.Bot.RemoveBotFromCall(ctx, "some_id"); err != nil {
+		t.Errorf("RemoveBotFromCall() error = %v", err)
+	}
+	if left != "some_id" {
+		t.Errorf("leave_call handler got bot ID %q, want %q", left, "some_id")
+	}
+
+	if _, err := client.Bot.GetBotLogs(ctx, "some_id"); err == nil {
+		t.Error("GetBotLogs() of an unrouted path succeeded, want a not found error")
+	}
+}
+
+type fakeBots struct {
+	recallaigo.BotService
+}
+
+func (fakeBots) RetrieveBot(ctx context.Context, botID string) (*recallaigo.Bot, error) {
+	return &recallaigo.Bot{ID: botID}, nil
+}
+
+// fetchBotID is code under test depending on the whole client.
+func fetchBotID(ctx context.Context, recall recallaigo.Recall, botID string) (string, error) {
+	bot, err := recall.BotService().RetrieveBot(ctx, botID)
+	if err != nil {
+		return "", err
+	}
+	return bot.ID, nil
+}
+
+func TestFakeRecall(t *testing.T) {
+	got, err := fetchBotID(context.Background(), &testutil.FakeRecall{Bot: fakeBots{}}, "some_id")
+	if err != nil || got != "some_id" {
+		t.Errorf("fetchBotID() = %q, %v, want %q", got, err, "some_id")
+	}
+
+	client := recallaigo.NewClient("some_token")
+	if client.BotService() != client.Bot || client.MediaService() != client.Media {
+		t.Error("the services of the client differ from its fields")
+	}
+}
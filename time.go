@@ -0,0 +1,109 @@
+package recallaigo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// timeLayouts are the ISO 8601 variants returned by the Recall.ai API.
+// Timestamps without a time zone are in UTC.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Time is a time.Time that decodes the ISO 8601 timestamps of the Recall.ai API.
+// Null and empty timestamps decode to the zero time, which encodes as null.
+type Time struct {
+	time.Time
+}
+
+// ParseTime parses an ISO 8601 timestamp as returned by the Recall.ai API.
+func ParseTime(s string) (Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Time{t}, nil
+		}
+	}
+	return Time{}, fmt.Errorf("invalid timestamp: %q", s)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+
+	parsed, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Duration is a time.Duration that the Recall.ai API represents as a number of seconds,
+// e.g. Duration{5 * time.Minute} encodes as 300. It is a struct so that plain numbers of seconds
+// do not compile as durations. Fractions of a second are rounded up when encoded, so that short
+// timeouts are never sent as 0, and negative durations fail to encode.
+type Duration struct {
+	time.Duration
+}
+
+// NewDuration returns a pointer to the Duration of d, for optional timeouts such as the ones of AutomaticLeave.
+func NewDuration(d time.Duration) *Duration {
+	return &Duration{d}
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if d.Duration < 0 {
+		return nil, fmt.Errorf("negative duration: %s", d.Duration)
+	}
+	seconds := d.Duration / time.Second
+	if d.Duration%time.Second != 0 {
+		seconds++
+	}
+	return json.Marshal(int64(seconds))
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*d = Duration{}
+		return nil
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	*d = Duration{secondsToDuration(seconds)}
+	return nil
+}
+
+// secondsToDuration converts the seconds of a timestamp relative to the start of a recording,
+// rounded to the nanosecond so that e.g. 0.3 is not off by floating point error.
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds * float64(time.Second)))
+}
@@ -0,0 +1,147 @@
+package recallaigo_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "RFC 3339 with milliseconds",
+			json: `"2025-03-18T10:13:10.433Z"`,
+			want: time.Date(2025, 3, 18, 10, 13, 10, 433000000, time.UTC),
+		},
+		{
+			name: "offset without colon",
+			json: `"2025-03-18T12:13:10+0200"`,
+			want: time.Date(2025, 3, 18, 10, 13, 10, 0, time.UTC),
+		},
+		{
+			name: "microseconds without time zone",
+			json: `"2025-03-18T10:13:10.433123"`,
+			want: time.Date(2025, 3, 18, 10, 13, 10, 433123000, time.UTC),
+		},
+		{
+			name: "space separator",
+			json: `"2025-03-18 10:13:10+00:00"`,
+			want: time.Date(2025, 3, 18, 10, 13, 10, 0, time.UTC),
+		},
+		{
+			name: "null",
+			json: `null`,
+		},
+		{
+			name: "empty",
+			json: `""`,
+		},
+		{
+			name:    "invalid",
+			json:    `"yesterday"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got recallaigo.Time
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("join time of bots", func(t *testing.T) {
+		var bot recallaigo.Bot
+		if err := json.Unmarshal([]byte(`{"id": "bot_id", "join_at": "2025-03-18T10:13:10.433123"}`), &bot); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+		if want := time.Date(2025, 3, 18, 10, 13, 10, 433123000, time.UTC); bot.JoinAt == nil || !bot.JoinAt.Equal(want) {
+			t.Errorf("JoinAt = %v, want %v", bot.JoinAt, want)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		change := recallaigo.StatusChange{Code: "done", CreatedAt: recallaigo.Time{Time: time.Date(2025, 3, 18, 10, 13, 10, 433000000, time.UTC)}}
+		data, err := json.Marshal(change)
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+
+		var got recallaigo.StatusChange
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+		if got != change {
+			t.Errorf("round trip = %+v, want %+v", got, change)
+		}
+	})
+
+	t.Run("unset times encode as null", func(t *testing.T) {
+		data, err := json.Marshal(recallaigo.Recording{ID: "recording_id"})
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+		want := `{"id":"recording_id","created_at":null,"started_at":null,"completed_at":null,"expires_at":null}`
+		if string(data) != want {
+			t.Errorf("MarshalJSON() = %s, want %s", data, want)
+		}
+	})
+}
+
+func TestDuration(t *testing.T) {
+	t.Run("MarshalJSON", func(t *testing.T) {
+		leave := recallaigo.AutomaticLeave{
+			NooneJoinedTimeout:  recallaigo.NewDuration(300 * time.Millisecond),
+			EveryoneLeftTimeout: recallaigo.Duration{2 * time.Second},
+			SilenceDetection: recallaigo.SilenceDetection{
+				Timeout:       recallaigo.NewDuration(5 * time.Minute),
+				ActivateAfter: recallaigo.NewDuration(1500 * time.Millisecond),
+			},
+		}
+		data, err := json.Marshal(leave)
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+
+		want := `{"noone_joined_timeout":1,"everyone_left_timeout":2,"silence_detection":{"timeout":300,"activate_after":2},"bot_detection":{"using_participant_events":{},"using_participant_names":{"timeout":0,"activate_after":0,"matches":null}}}`
+		if string(data) != want {
+			t.Errorf("MarshalJSON() = %s, want %s", data, want)
+		}
+	})
+
+	t.Run("MarshalJSON rejects negative durations", func(t *testing.T) {
+		if _, err := json.Marshal(recallaigo.Duration{-time.Second}); err == nil {
+			t.Error("MarshalJSON() error = nil, want an error")
+		}
+	})
+
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		var leave recallaigo.AutomaticLeave
+		if err := json.Unmarshal([]byte(`{"waiting_room_timeout": 1200, "silence_detection": {"timeout": 2.5, "activate_after": null}}`), &leave); err != nil {
+			t.Fatalf("UnmarshalJSON() error = %v", err)
+		}
+
+		if leave.WaitingRoomTimeout == nil || leave.WaitingRoomTimeout.Duration != 20*time.Minute {
+			t.Errorf("WaitingRoomTimeout = %v, want 20m", leave.WaitingRoomTimeout)
+		}
+		if leave.SilenceDetection.Timeout == nil || leave.SilenceDetection.Timeout.Duration != 2500*time.Millisecond {
+			t.Errorf("SilenceDetection.Timeout = %v, want 2.5s", leave.SilenceDetection.Timeout)
+		}
+		if leave.SilenceDetection.ActivateAfter != nil {
+			t.Errorf("SilenceDetection.ActivateAfter = %v, want nil", leave.SilenceDetection.ActivateAfter)
+		}
+	})
+}
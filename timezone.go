@@ -0,0 +1,83 @@
+package recallaigo
+
+import (
+	"fmt"
+	"time"
+)
+
+// localTimeLayouts are the wall-clock times accepted by ParseLocalTime, without a UTC offset.
+var localTimeLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// ParseLocalTime parses a wall-clock time such as "2025-03-30 10:00" in the IANA time zone,
+// e.g. "Europe/Berlin". The UTC offset is the one of the time zone on that date. Times skipped
+// by a daylight saving time change are rejected; of the times repeated by one, the earlier is used.
+func ParseLocalTime(local, timezone string) (time.Time, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load time zone: %w", err)
+	}
+
+	for _, layout := range localTimeLayouts {
+		wall, err := time.Parse(layout, local)
+		if err != nil {
+			continue
+		}
+
+		t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+		if !sameWallClock(t, wall) {
+			return time.Time{}, fmt.Errorf("local time %q does not exist in %s", local, timezone)
+		}
+		return earliest(t, wall), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid local time: %q", local)
+}
+
+// earliest returns the earlier instant showing the wall-clock time if t is repeated by a change
+// of UTC offset, which need not be an hour, e.g. 30 minutes in Australia/Lord_Howe, and t itself
+// otherwise. The earlier instant is in the zone before the change, whose offset is larger.
+func earliest(t, wall time.Time) time.Time {
+	start, _ := t.ZoneBounds()
+	if start.IsZero() {
+		return t
+	}
+	_, before := start.Add(-time.Nanosecond).Zone()
+	_, offset := t.Zone()
+	if before <= offset {
+		return t
+	}
+	if earlier := t.Add(-time.Duration(before-offset) * time.Second); sameWallClock(earlier, wall) {
+		return earlier
+	}
+	return t
+}
+
+// sameWallClock reports whether t shows the date and time of wall in its location.
+func sameWallClock(t, wall time.Time) bool {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := wall.Date()
+	h1, min1, s1 := t.Clock()
+	h2, min2, s2 := wall.Clock()
+	return y1 == y2 && m1 == m2 && d1 == d2 && h1 == h2 && min1 == min2 && s1 == s2
+}
+
+// ScheduleAtLocal returns the JoinAt of a bot joining early before a meeting that starts at the
+// wall-clock time in the IANA time zone, e.g. 2 minutes before "2025-03-30 10:00" in "Europe/Berlin".
+// See ParseLocalTime for the accepted times.
+func ScheduleAtLocal(local, timezone string, early time.Duration) (*time.Time, error) {
+	start, err := ParseLocalTime(local, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return ScheduleAt(start.Add(-early)), nil
+}
+
+// FormatTime formats t as an ISO 8601 timestamp in UTC, as expected by the API, e.g. for the
+// JoinAtAfter filter of ListBotsParams.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,88 @@
+package recallaigo_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestScheduleAtLocal(t *testing.T) {
+	tests := []struct {
+		name     string
+		local    string
+		timezone string
+		early    time.Duration
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "winter time",
+			local:    "2025-03-29 10:00",
+			timezone: "Europe/Berlin",
+			want:     "2025-03-29T09:00:00Z",
+		},
+		{
+			name:     "summer time after the change",
+			local:    "2025-03-30T10:00",
+			timezone: "Europe/Berlin",
+			early:    2 * time.Minute,
+			want:     "2025-03-30T07:58:00Z",
+		},
+		{
+			name:     "southern hemisphere",
+			local:    "2025-01-15 09:30:00",
+			timezone: "Australia/Sydney",
+			want:     "2025-01-14T22:30:00Z",
+		},
+		{
+			name:     "repeated time uses the earlier",
+			local:    "2025-11-02 01:30",
+			timezone: "America/New_York",
+			want:     "2025-11-02T05:30:00Z",
+		},
+		{
+			name:     "repeated time with a half-hour change uses the earlier",
+			local:    "2025-04-06 01:45",
+			timezone: "Australia/Lord_Howe",
+			want:     "2025-04-05T14:45:00Z",
+		},
+		{
+			name:     "skipped time",
+			local:    "2025-03-09 02:30",
+			timezone: "America/New_York",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown time zone",
+			local:    "2025-03-09 10:00",
+			timezone: "Mars/Olympus_Mons",
+			wantErr:  true,
+		},
+		{
+			name:     "time with offset",
+			local:    "2025-03-09T10:00:00+01:00",
+			timezone: "Europe/Berlin",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := recallaigo.ScheduleAtLocal(tt.local, tt.timezone, tt.early)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ScheduleAtLocal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if s := recallaigo.FormatTime(*got); s != tt.want {
+				t.Errorf("ScheduleAtLocal() = %s, want %s", s, tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("ScheduleAtLocal() location = %v, want UTC", got.Location())
+			}
+		})
+	}
+}
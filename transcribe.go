@@ -0,0 +1,86 @@
+package recallaigo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrAnalysisFailed is returned by TranscribeAndWait when the analysis of the bot's media fails.
+var ErrAnalysisFailed = errors.New("analysis failed")
+
+// TranscribeAndWaitOptions configures the TranscribeAndWait method.
+type TranscribeAndWaitOptions struct {
+	// How often the bot is polled for the end of the analysis. Defaults to 10 seconds.
+	Interval time.Duration
+	// Checks the bot immediately whenever a value is received, e.g. from a webhook handler
+	// receiving bot.analysis_done or bot.analysis_failed events. Polling continues as a fallback.
+	Signal <-chan struct{}
+	// The options of the AnalyzeBotMedia call.
+	Analyze AnalyzeBotMediaOptions
+}
+
+// TranscribeAndWait transcribes the bot's media with the provider of the request, waits for
+// the analysis to finish and returns the resulting transcript as GetBotTranscript returns it with
+// UseAsyncTranscription. The API already converts the output of every provider into transcript
+// entries, so unlike the raw output passed to NormalizeTranscript, it needs no further conversion.
+// If the analysis fails, the error wraps ErrAnalysisFailed.
+func (c *BotClient) TranscribeAndWait(ctx context.Context, botID string, request *AnalyzeBotMediaRequest, opts ...TranscribeAndWaitOptions) ([]TranscriptEntry, error) {
+	var opt TranscribeAndWaitOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if opt.Interval <= 0 {
+		opt.Interval = defaultPollInterval
+	}
+
+	// Earlier analyses of the bot also left status changes, so only newer ones count.
+	bot, err := c.RetrieveBot(ctx, botID)
+	if err != nil {
+		return nil, err
+	}
+	seen := len(analysisStatusChanges(bot))
+
+	if _, err := c.AnalyzeBotMedia(ctx, botID, request, opt.Analyze); err != nil {
+		return nil, err
+	}
+
+	ticker := c.client.clock.NewTicker(opt.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C():
+		case <-opt.Signal:
+		}
+
+		bot, err := c.RetrieveBot(ctx, botID)
+		if err != nil {
+			return nil, err
+		}
+
+		changes := analysisStatusChanges(bot)
+		if len(changes) <= seen {
+			continue
+		}
+		if last := changes[len(changes)-1]; last.Code == StatusAnalysisFailed {
+			return nil, fmt.Errorf("%w: %s", ErrAnalysisFailed, last.Explanation())
+		}
+
+		return c.GetBotTranscript(ctx, botID, GetBotTranscriptParams{UseAsyncTranscription: true})
+	}
+}
+
+// analysisStatusChanges returns the status changes of the bot that end an analysis.
+func analysisStatusChanges(bot *Bot) []StatusChange {
+	var changes []StatusChange
+	for _, change := range bot.StatusChanges {
+		if change.Code == StatusAnalysisDone || change.Code == StatusAnalysisFailed {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
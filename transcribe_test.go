@@ -0,0 +1,136 @@
+package recallaigo_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestTranscribeAndWait(t *testing.T) {
+	const (
+		done           = `{"code": "done", "created_at": "2025-03-18T10:00:00Z"}`
+		analysisDone   = `{"code": "analysis_done", "created_at": "2025-03-18T10:05:00Z"}`
+		analysisFailed = `{"code": "analysis_failed", "created_at": "2025-03-18T10:10:00Z"}`
+	)
+
+	tests := []struct {
+		name string
+		// The status changes returned by each retrieval of the bot, the last one repeating.
+		statuses  [][]string
+		wantErrIs error
+	}{
+		{
+			name: "returns the transcript after the analysis",
+			statuses: [][]string{
+				{done},
+				{done},
+				{done, analysisDone},
+			},
+		},
+		{
+			name: "ignores earlier analyses",
+			statuses: [][]string{
+				{done, analysisDone},
+				{done, analysisDone},
+				{done, analysisDone, analysisDone},
+			},
+		},
+		{
+			name: "returns failed analyses",
+			statuses: [][]string{
+				{done, analysisDone},
+				{done, analysisDone, analysisFailed},
+			},
+			wantErrIs: recallaigo.ErrAnalysisFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				retrievals int
+				analyzed   bool
+				query      string
+			)
+			c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+				switch {
+				case strings.HasSuffix(req.URL.Path, "/analyze"):
+					analyzed = true
+					return testutil.NewStringResponse(`{"job_id": "job"}`, http.StatusOK)
+				case strings.HasSuffix(req.URL.Path, "/transcript"):
+					query = req.URL.RawQuery
+					return testutil.NewFileResponse(t, "test_data/get_bot_transcript.json", http.StatusOK)
+				}
+
+				statuses := tt.statuses[min(retrievals, len(tt.statuses)-1)]
+				retrievals++
+				return testutil.NewStringResponse(`{"id": "some_id", "status_changes": [`+strings.Join(statuses, ",")+`]}`, http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+			request := &recallaigo.AnalyzeBotMediaRequest{
+				DeepgramAsyncTranscription: &recallaigo.DeepgramAsyncTranscription{},
+			}
+			entries, err := client.Bot.TranscribeAndWait(context.Background(), "some_id", request, recallaigo.TranscribeAndWaitOptions{Interval: time.Millisecond})
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Fatalf("TranscribeAndWait() error = %v, want %v", err, tt.wantErrIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TranscribeAndWait() error = %v", err)
+			}
+
+			if !analyzed {
+				t.Error("TranscribeAndWait() did not submit the analysis")
+			}
+			if retrievals != len(tt.statuses) {
+				t.Errorf("TranscribeAndWait() retrieved the bot %d times, want %d", retrievals, len(tt.statuses))
+			}
+			if query != "use_async_transcription=true" {
+				t.Errorf("TranscribeAndWait() transcript query = %q, want use_async_transcription=true", query)
+			}
+			if len(entries) == 0 {
+				t.Error("TranscribeAndWait() returned no entries")
+			}
+		})
+	}
+}
+
+func TestTranscribeAndWaitSignal(t *testing.T) {
+	var retrievals int
+	c := testutil.NewTestClient(func(req *http.Request) *http.Response {
+		if strings.HasSuffix(req.URL.Path, "/analyze") {
+			return testutil.NewStringResponse(`{"job_id": "job"}`, http.StatusOK)
+		}
+		if strings.HasSuffix(req.URL.Path, "/transcript") {
+			return testutil.NewStringResponse(`[]`, http.StatusOK)
+		}
+		retrievals++
+		if retrievals == 1 {
+			return testutil.NewStringResponse(`{"id": "some_id", "status_changes": []}`, http.StatusOK)
+		}
+		return testutil.NewStringResponse(`{"id": "some_id", "status_changes": [{"code": "analysis_done"}]}`, http.StatusOK)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	signal := make(chan struct{}, 1)
+	signal <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	request := &recallaigo.AnalyzeBotMediaRequest{
+		DeepgramAsyncTranscription: &recallaigo.DeepgramAsyncTranscription{},
+	}
+	if _, err := client.Bot.TranscribeAndWait(ctx, "some_id", request, recallaigo.TranscribeAndWaitOptions{Interval: time.Hour, Signal: signal}); err != nil {
+		t.Fatalf("TranscribeAndWait() error = %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package recallaigo
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TextRenderOptions configures the RenderTranscriptText function.
+type TextRenderOptions struct {
+	// Prefix each speaker turn with its start time, e.g. "[00:03:12]".
+	Timestamps bool
+	// Wrap lines longer than this many characters. Zero disables wrapping.
+	WrapWidth int
+	// Merge consecutive entries from the same speaker into a single turn.
+	MergeConsecutive bool
+}
+
+// RenderTranscriptText writes a readable transcript with one speaker turn per line,
+// e.g. "[00:03:12] Alice: Hello everyone".
+func RenderTranscriptText(w io.Writer, entries []TranscriptEntry, opts ...TextRenderOptions) error {
+	var opt TextRenderOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	if opt.MergeConsecutive {
+		entries = mergeConsecutiveEntries(entries)
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, entry := range entries {
+		text := entryText(entry)
+		if text == "" {
+			continue
+		}
+
+		prefix := speakerLabel(entry) + ": "
+		if opt.Timestamps && len(entry.Words) > 0 {
+			prefix = fmt.Sprintf("[%s] %s", formatTimestamp(entry.Words[0].StartTimestamp), prefix)
+		}
+
+		for i, line := range wrapText(text, opt.WrapWidth-len(prefix)) {
+			if i == 0 {
+				bw.WriteString(prefix)
+			} else {
+				bw.WriteString(strings.Repeat(" ", len(prefix)))
+			}
+			bw.WriteString(line)
+			bw.WriteByte('\n')
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Utterance is a flattened transcript entry with its text and timing.
+type Utterance struct {
+	Speaker   string `json:"speaker"`
+	SpeakerID int    `json:"speaker_id"`
+	// The start time in seconds since the start of the recording.
+	Start float64 `json:"start"`
+	// The end time in seconds since the start of the recording.
+	End  float64 `json:"end"`
+	Text string  `json:"text"`
+	// The average confidence of the words.
+	Confidence float64 `json:"confidence"`
+}
+
+// Utterances converts transcript entries into utterances. Entries without words are skipped.
+func Utterances(entries []TranscriptEntry) []Utterance {
+	utterances := make([]Utterance, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.Words) == 0 {
+			continue
+		}
+
+		var confidence float64
+		for _, word := range entry.Words {
+			confidence += word.Confidence
+		}
+
+		utterances = append(utterances, Utterance{
+			Speaker:    entry.Speaker,
+			SpeakerID:  entry.SpeakerID,
+			Start:      entry.Words[0].StartTimestamp,
+			End:        entry.Words[len(entry.Words)-1].EndTimestamp,
+			Text:       entryText(entry),
+			Confidence: confidence / float64(len(entry.Words)),
+		})
+	}
+	return utterances
+}
+
+// WriteTranscriptJSONL writes one JSON encoded Utterance per line.
+func WriteTranscriptJSONL(w io.Writer, entries []TranscriptEntry) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for _, utterance := range Utterances(entries) {
+		if err := enc.Encode(utterance); err != nil {
+			return fmt.Errorf("failed to encode utterance: %w", err)
+		}
+	}
+	return bw.Flush()
+}
+
+// RenderTranscriptSRT writes the transcript as SubRip (.srt) subtitles, with one cue per entry
+// labeled with its speaker. Entries without words are skipped.
+func RenderTranscriptSRT(w io.Writer, entries []TranscriptEntry) error {
+	bw := bufio.NewWriter(w)
+	cue := 0
+	for _, entry := range entries {
+		text := entryText(entry)
+		if text == "" {
+			continue
+		}
+
+		cue++
+		start, end := entry.Words[0].StartTimestamp, entry.Words[len(entry.Words)-1].EndTimestamp
+		fmt.Fprintf(bw, "%d\n%s --> %s\n%s: %s\n\n", cue, formatSRTTimestamp(start), formatSRTTimestamp(end), speakerLabel(entry), text)
+	}
+	return bw.Flush()
+}
+
+// formatSRTTimestamp formats seconds since the start of the recording as hh:mm:ss,mmm.
+func formatSRTTimestamp(seconds float64) string {
+	ms := int(seconds*1000 + 0.5)
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// entryText joins the words of the entry with spaces.
+func entryText(entry TranscriptEntry) string {
+	words := make([]string, 0, len(entry.Words))
+	for _, word := range entry.Words {
+		if word.Text != "" {
+			words = append(words, word.Text)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// speakerLabel returns the speaker name, falling back to the speaker ID.
+func speakerLabel(entry TranscriptEntry) string {
+	if entry.Speaker != "" {
+		return entry.Speaker
+	}
+	return fmt.Sprintf("Speaker %d", entry.SpeakerID)
+}
+
+// formatTimestamp formats seconds since the start of the recording as hh:mm:ss.
+func formatTimestamp(seconds float64) string {
+	s := int(seconds)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// mergeConsecutiveEntries merges adjacent entries of the same speaker.
+func mergeConsecutiveEntries(entries []TranscriptEntry) []TranscriptEntry {
+	var merged []TranscriptEntry
+	for _, entry := range entries {
+		if n := len(merged); n > 0 && merged[n-1].SpeakerID == entry.SpeakerID && merged[n-1].Speaker == entry.Speaker {
+			last := &merged[n-1]
+			last.Words = append(last.Words[:len(last.Words):len(last.Words)], entry.Words...)
+			continue
+		}
+		merged = append(merged, entry)
+	}
+	return merged
+}
+
+// wrapText splits text into lines of at most width characters, breaking at spaces.
+// Words longer than width are kept whole. A non-positive width disables wrapping.
+func wrapText(text string, width int) []string {
+	if width <= 0 || len(text) <= width {
+		return []string{text}
+	}
+
+	var (
+		lines []string
+		line  strings.Builder
+	)
+	for _, word := range strings.Fields(text) {
+		if line.Len() > 0 && line.Len()+1+len(word) > width {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(word)
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
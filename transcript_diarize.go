@@ -0,0 +1,52 @@
+package recallaigo
+
+import (
+	"slices"
+	"sort"
+)
+
+// DiarizeTranscript attributes every word of the transcript to the participant that was
+// speaking according to the speaker timeline, and regroups the words into entries per speaker turn.
+// This fills in speakers for transcription providers without diarization and corrects
+// misattributed words. Words spoken before the first timeline entry, or while the timeline
+// reports no speaker, keep their original attribution.
+func DiarizeTranscript(entries []TranscriptEntry, timeline []SpeakerTimelineEntry) []TranscriptEntry {
+	timeline = slices.Clone(timeline)
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Timestamp < timeline[j].Timestamp
+	})
+
+	var diarized []TranscriptEntry
+	for _, entry := range entries {
+		for _, word := range entry.Words {
+			speaker, speakerID := entry.Speaker, entry.SpeakerID
+			if active := activeSpeaker(timeline, word.StartTimestamp); active != nil && active.Name != "" {
+				speaker, speakerID = active.Name, active.UserID
+			}
+
+			if n := len(diarized); n > 0 && diarized[n-1].SpeakerID == speakerID && diarized[n-1].Speaker == speaker {
+				diarized[n-1].Words = append(diarized[n-1].Words, word)
+				continue
+			}
+			diarized = append(diarized, TranscriptEntry{
+				Speaker:   speaker,
+				SpeakerID: speakerID,
+				Language:  entry.Language,
+				Words:     []WordDetail{word},
+			})
+		}
+	}
+	return diarized
+}
+
+// activeSpeaker returns the last timeline entry at or before the timestamp, or nil.
+// The timeline must be sorted by timestamp.
+func activeSpeaker(timeline []SpeakerTimelineEntry, timestamp float64) *SpeakerTimelineEntry {
+	i := sort.Search(len(timeline), func(i int) bool {
+		return timeline[i].Timestamp > timestamp
+	})
+	if i == 0 {
+		return nil
+	}
+	return &timeline[i-1]
+}
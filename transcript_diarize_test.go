@@ -0,0 +1,38 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestDiarizeTranscript(t *testing.T) {
+	entries := []recallaigo.TranscriptEntry{
+		newTranscriptEntry("", 0, 0, "hello there hi bob how are you"),
+	}
+	timeline := []recallaigo.SpeakerTimelineEntry{
+		{Name: "Bob", UserID: 2, Timestamp: 2},
+		{Name: "Alice", UserID: 1, Timestamp: 0.5},
+		{Name: "Alice", UserID: 1, Timestamp: 4},
+	}
+
+	got := recallaigo.DiarizeTranscript(entries, timeline)
+
+	want := []struct {
+		speaker string
+		text    string
+	}{
+		{speaker: "", text: "hello"},
+		{speaker: "Alice", text: "there"},
+		{speaker: "Bob", text: "hi bob"},
+		{speaker: "Alice", text: "how are you"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("DiarizeTranscript() returned %d entries, want %d", len(got), len(want))
+	}
+	for i, utterance := range recallaigo.Utterances(got) {
+		if utterance.Speaker != want[i].speaker || utterance.Text != want[i].text {
+			t.Errorf("DiarizeTranscript()[%d] = %s: %q, want %s: %q", i, utterance.Speaker, utterance.Text, want[i].speaker, want[i].text)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package recallaigo
+
+// undeterminedLanguage is the BCP 47 code used for words without a language.
+const undeterminedLanguage = "und"
+
+// LanguageStats is the usage of a single language.
+type LanguageStats struct {
+	Words int
+	// The total duration of the words in seconds.
+	Seconds float64
+}
+
+// LanguageUsage is the usage of each language, keyed by language code.
+type LanguageUsage map[string]LanguageStats
+
+// Dominant returns the language with the most words, or an empty string if there are none.
+// Ties are broken by the language code so the result is stable.
+func (u LanguageUsage) Dominant() string {
+	var dominant string
+	for language, stats := range u {
+		if best, ok := u[dominant]; !ok || stats.Words > best.Words || stats.Words == best.Words && language < dominant {
+			dominant = language
+		}
+	}
+	return dominant
+}
+
+// Share returns the fraction of words spoken in the language.
+func (u LanguageUsage) Share(language string) float64 {
+	var total int
+	for _, stats := range u {
+		total += stats.Words
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(u[language].Words) / float64(total)
+}
+
+// LanguageSummary is the language distribution of a transcript.
+type LanguageSummary struct {
+	Meeting LanguageUsage
+	// The usage of each speaker, keyed by speaker name or "Speaker N" if the name is unknown.
+	Speakers map[string]LanguageUsage
+}
+
+// SummarizeLanguages counts the words of each language for the whole transcript and per speaker.
+// Words without a language fall back to the language of their entry, then to "und".
+func SummarizeLanguages(entries []TranscriptEntry) LanguageSummary {
+	summary := LanguageSummary{
+		Meeting:  LanguageUsage{},
+		Speakers: map[string]LanguageUsage{},
+	}
+
+	for _, entry := range entries {
+		speaker := speakerLabel(entry)
+		if summary.Speakers[speaker] == nil {
+			summary.Speakers[speaker] = LanguageUsage{}
+		}
+
+		for _, word := range entry.Words {
+			language := word.Language
+			if language == "" {
+				language = entry.Language
+			}
+			if language == "" {
+				language = undeterminedLanguage
+			}
+
+			for _, usage := range []LanguageUsage{summary.Meeting, summary.Speakers[speaker]} {
+				stats := usage[language]
+				stats.Words++
+				stats.Seconds += word.EndTimestamp - word.StartTimestamp
+				usage[language] = stats
+			}
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,70 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestSummarizeLanguages(t *testing.T) {
+	spanish := newTranscriptEntry("Bob", 2, 10, "hola a todos")
+	for i := range spanish.Words {
+		spanish.Words[i].Language = "es"
+	}
+	unknown := newTranscriptEntry("", 3, 20, "ok")
+	unknown.Language = ""
+	unknown.Words[0].Language = ""
+
+	summary := recallaigo.SummarizeLanguages([]recallaigo.TranscriptEntry{
+		newTranscriptEntry("Alice", 1, 0, "hello everyone"),
+		spanish,
+		newTranscriptEntry("Bob", 2, 15, "welcome"),
+		unknown,
+	})
+
+	tests := []struct {
+		name         string
+		usage        recallaigo.LanguageUsage
+		wantDominant string
+		wantWords    map[string]int
+	}{
+		{
+			name:         "meeting",
+			usage:        summary.Meeting,
+			wantDominant: "en",
+			wantWords:    map[string]int{"en": 3, "es": 3, "und": 1},
+		},
+		{
+			name:         "Bob",
+			usage:        summary.Speakers["Bob"],
+			wantDominant: "es",
+			wantWords:    map[string]int{"en": 1, "es": 3},
+		},
+		{
+			name:         "Speaker 3",
+			usage:        summary.Speakers["Speaker 3"],
+			wantDominant: "und",
+			wantWords:    map[string]int{"und": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.usage.Dominant(); got != tt.wantDominant {
+				t.Errorf("Dominant() = %q, want %q", got, tt.wantDominant)
+			}
+			if len(tt.usage) != len(tt.wantWords) {
+				t.Errorf("usage has %d languages, want %d", len(tt.usage), len(tt.wantWords))
+			}
+			for language, words := range tt.wantWords {
+				if got := tt.usage[language]; got.Words != words || got.Seconds != float64(words) {
+					t.Errorf("usage[%q] = %+v, want %d words over %d seconds", language, got, words, words)
+				}
+			}
+		})
+	}
+
+	if got := summary.Speakers["Bob"].Share("es"); got != 0.75 {
+		t.Errorf("Share() = %v, want 0.75", got)
+	}
+}
@@ -0,0 +1,231 @@
+package recallaigo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NormalizeTranscript converts the raw output of a transcription provider, such as the result
+// of an async analysis job, into transcript entries. Consecutive words of the same speaker
+// are grouped into one entry. Providers do not report speaker names, so only SpeakerID is set.
+//
+// Supported providers are Deepgram, AssemblyAI, Rev, Speechmatics and meeting captions,
+// which are already in the transcript shape.
+func NormalizeTranscript(provider TranscriptionProvider, raw []byte) ([]TranscriptEntry, error) {
+	var (
+		entries []TranscriptEntry
+		err     error
+	)
+	switch provider {
+	case TranscriptionProviderMeetingCaptions:
+		err = json.Unmarshal(raw, &entries)
+	case TranscriptionProviderDeepgram:
+		entries, err = normalizeDeepgram(raw)
+	case TranscriptionProviderAssemblyAI, TranscriptionProviderAssemblyAIAsyncChunked:
+		entries, err = normalizeAssemblyAI(raw)
+	case TranscriptionProviderRev:
+		entries, err = normalizeRev(raw)
+	case TranscriptionProviderSpeechmatics:
+		entries, err = normalizeSpeechmatics(raw)
+	default:
+		return nil, fmt.Errorf("unsupported transcription provider: %s", provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s transcript: %w", provider, err)
+	}
+
+	return entries, nil
+}
+
+func normalizeDeepgram(raw []byte) ([]TranscriptEntry, error) {
+	var result struct {
+		Results struct {
+			Channels []struct {
+				DetectedLanguage string `json:"detected_language"`
+				Alternatives     []struct {
+					Words []struct {
+						Word           string  `json:"word"`
+						PunctuatedWord string  `json:"punctuated_word"`
+						Start          float64 `json:"start"`
+						End            float64 `json:"end"`
+						Confidence     float64 `json:"confidence"`
+						Speaker        int     `json:"speaker"`
+					} `json:"words"`
+				} `json:"alternatives"`
+			} `json:"channels"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	var entries []TranscriptEntry
+	for _, channel := range result.Results.Channels {
+		if len(channel.Alternatives) == 0 {
+			continue
+		}
+		for _, w := range channel.Alternatives[0].Words {
+			text := w.PunctuatedWord
+			if text == "" {
+				text = w.Word
+			}
+			entries = appendTranscriptWord(entries, w.Speaker, WordDetail{
+				Text:           text,
+				StartTimestamp: w.Start,
+				EndTimestamp:   w.End,
+				Language:       channel.DetectedLanguage,
+				Confidence:     w.Confidence,
+			})
+		}
+	}
+	return entries, nil
+}
+
+func normalizeAssemblyAI(raw []byte) ([]TranscriptEntry, error) {
+	type word struct {
+		Text       string  `json:"text"`
+		Start      float64 `json:"start"`
+		End        float64 `json:"end"`
+		Confidence float64 `json:"confidence"`
+		Speaker    string  `json:"speaker"`
+	}
+	var result struct {
+		LanguageCode string `json:"language_code"`
+		Utterances   []struct {
+			Words []word `json:"words"`
+		} `json:"utterances"`
+		Words []word `json:"words"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	// Without speaker labels there are no utterances, only words.
+	words := result.Words
+	if len(result.Utterances) > 0 {
+		words = nil
+		for _, utterance := range result.Utterances {
+			words = append(words, utterance.Words...)
+		}
+	}
+
+	var entries []TranscriptEntry
+	for _, w := range words {
+		// AssemblyAI labels speakers A, B, C... and reports times in milliseconds.
+		var speakerID int
+		if w.Speaker != "" {
+			speakerID = int(w.Speaker[0] - 'A')
+		}
+		entries = appendTranscriptWord(entries, speakerID, WordDetail{
+			Text:           w.Text,
+			StartTimestamp: w.Start / 1000,
+			EndTimestamp:   w.End / 1000,
+			Language:       result.LanguageCode,
+			Confidence:     w.Confidence,
+		})
+	}
+	return entries, nil
+}
+
+func normalizeRev(raw []byte) ([]TranscriptEntry, error) {
+	var result struct {
+		Monologues []struct {
+			Speaker  int `json:"speaker"`
+			Elements []struct {
+				Type       string  `json:"type"`
+				Value      string  `json:"value"`
+				Ts         float64 `json:"ts"`
+				EndTs      float64 `json:"end_ts"`
+				Confidence float64 `json:"confidence"`
+			} `json:"elements"`
+		} `json:"monologues"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	var entries []TranscriptEntry
+	for _, monologue := range result.Monologues {
+		for _, element := range monologue.Elements {
+			switch element.Type {
+			case "text":
+				entries = appendTranscriptWord(entries, monologue.Speaker, WordDetail{
+					Text:           element.Value,
+					StartTimestamp: element.Ts,
+					EndTimestamp:   element.EndTs,
+					Confidence:     element.Confidence,
+				})
+			case "punct":
+				appendTranscriptPunctuation(entries, strings.TrimSpace(element.Value))
+			}
+		}
+	}
+	return entries, nil
+}
+
+func normalizeSpeechmatics(raw []byte) ([]TranscriptEntry, error) {
+	var result struct {
+		Results []struct {
+			Type         string  `json:"type"`
+			StartTime    float64 `json:"start_time"`
+			EndTime      float64 `json:"end_time"`
+			Alternatives []struct {
+				Content    string  `json:"content"`
+				Confidence float64 `json:"confidence"`
+				Language   string  `json:"language"`
+				Speaker    string  `json:"speaker"`
+			} `json:"alternatives"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	var entries []TranscriptEntry
+	for _, r := range result.Results {
+		if len(r.Alternatives) == 0 {
+			continue
+		}
+		alternative := r.Alternatives[0]
+
+		switch r.Type {
+		case "word":
+			// Speechmatics labels speakers S1, S2... and UU when unknown.
+			speakerID, _ := strconv.Atoi(strings.TrimPrefix(alternative.Speaker, "S"))
+			entries = appendTranscriptWord(entries, speakerID, WordDetail{
+				Text:           alternative.Content,
+				StartTimestamp: r.StartTime,
+				EndTimestamp:   r.EndTime,
+				Language:       alternative.Language,
+				Confidence:     alternative.Confidence,
+			})
+		case "punctuation":
+			appendTranscriptPunctuation(entries, alternative.Content)
+		}
+	}
+	return entries, nil
+}
+
+// appendTranscriptWord appends the word to the last entry if it has the same speaker,
+// and starts a new entry otherwise.
+func appendTranscriptWord(entries []TranscriptEntry, speakerID int, word WordDetail) []TranscriptEntry {
+	if n := len(entries); n > 0 && entries[n-1].SpeakerID == speakerID {
+		entries[n-1].Words = append(entries[n-1].Words, word)
+		return entries
+	}
+	return append(entries, TranscriptEntry{
+		SpeakerID: speakerID,
+		Language:  word.Language,
+		Words:     []WordDetail{word},
+	})
+}
+
+// appendTranscriptPunctuation attaches punctuation to the last word of the transcript.
+func appendTranscriptPunctuation(entries []TranscriptEntry, punctuation string) {
+	if n := len(entries); n > 0 && punctuation != "" {
+		words := entries[n-1].Words
+		words[len(words)-1].Text += punctuation
+	}
+}
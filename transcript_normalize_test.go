@@ -0,0 +1,120 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestNormalizeTranscript(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider recallaigo.TranscriptionProvider
+		raw      string
+		want     []recallaigo.Utterance
+		wantErr  bool
+	}{
+		{
+			name:     "deepgram",
+			provider: recallaigo.TranscriptionProviderDeepgram,
+			raw: `{"results": {"channels": [{"detected_language": "en", "alternatives": [{"words": [
+				{"word": "hello", "punctuated_word": "Hello.", "start": 0.5, "end": 1, "confidence": 1, "speaker": 0},
+				{"word": "hi", "punctuated_word": "Hi!", "start": 1.5, "end": 2, "confidence": 0.5, "speaker": 1}
+			]}]}]}}`,
+			want: []recallaigo.Utterance{
+				{SpeakerID: 0, Start: 0.5, End: 1, Text: "Hello.", Confidence: 1},
+				{SpeakerID: 1, Start: 1.5, End: 2, Text: "Hi!", Confidence: 0.5},
+			},
+		},
+		{
+			name:     "assemblyai",
+			provider: recallaigo.TranscriptionProviderAssemblyAI,
+			raw: `{"language_code": "en", "utterances": [
+				{"speaker": "A", "words": [{"text": "Hello", "start": 500, "end": 1000, "confidence": 1, "speaker": "A"}]},
+				{"speaker": "B", "words": [{"text": "Hi", "start": 1500, "end": 2000, "confidence": 0.5, "speaker": "B"}]}
+			]}`,
+			want: []recallaigo.Utterance{
+				{SpeakerID: 0, Start: 0.5, End: 1, Text: "Hello", Confidence: 1},
+				{SpeakerID: 1, Start: 1.5, End: 2, Text: "Hi", Confidence: 0.5},
+			},
+		},
+		{
+			name:     "rev",
+			provider: recallaigo.TranscriptionProviderRev,
+			raw: `{"monologues": [{"speaker": 1, "elements": [
+				{"type": "text", "value": "Hello", "ts": 0.5, "end_ts": 1, "confidence": 1},
+				{"type": "punct", "value": " "},
+				{"type": "text", "value": "there", "ts": 1, "end_ts": 1.5, "confidence": 1},
+				{"type": "punct", "value": "."}
+			]}]}`,
+			want: []recallaigo.Utterance{
+				{SpeakerID: 1, Start: 0.5, End: 1.5, Text: "Hello there.", Confidence: 1},
+			},
+		},
+		{
+			name:     "speechmatics",
+			provider: recallaigo.TranscriptionProviderSpeechmatics,
+			raw: `{"results": [
+				{"type": "word", "start_time": 0.5, "end_time": 1, "alternatives": [{"content": "Hello", "confidence": 1, "language": "en", "speaker": "S1"}]},
+				{"type": "punctuation", "start_time": 1, "end_time": 1, "alternatives": [{"content": ",", "confidence": 1, "speaker": "S1"}]},
+				{"type": "word", "start_time": 1.5, "end_time": 2, "alternatives": [{"content": "hi", "confidence": 0.5, "language": "en", "speaker": "S2"}]}
+			]}`,
+			want: []recallaigo.Utterance{
+				{SpeakerID: 1, Start: 0.5, End: 1, Text: "Hello,", Confidence: 1},
+				{SpeakerID: 2, Start: 1.5, End: 2, Text: "hi", Confidence: 0.5},
+			},
+		},
+		{
+			name:     "meeting captions",
+			provider: recallaigo.TranscriptionProviderMeetingCaptions,
+			raw:      `[{"speaker": "Alice", "speaker_id": 3, "words": [{"text": "Hello", "start_timestamp": 0.5, "end_timestamp": 1, "confidence": 1}]}]`,
+			want: []recallaigo.Utterance{
+				{Speaker: "Alice", SpeakerID: 3, Start: 0.5, End: 1, Text: "Hello", Confidence: 1},
+			},
+		},
+		{
+			name:     "meeting captions with timestamp objects",
+			provider: recallaigo.TranscriptionProviderMeetingCaptions,
+			raw: `[{"speaker": "Alice", "speaker_id": null, "language": null, "words": [{
+				"text": "Hello there",
+				"start_timestamp": {"relative": 0.5, "absolute": "2025-03-18T10:00:00.5Z"},
+				"end_timestamp": {"relative": 1.5, "absolute": "2025-03-18T10:00:01.5Z"},
+				"confidence": null
+			}]}]`,
+			want: []recallaigo.Utterance{
+				{Speaker: "Alice", Start: 0.5, End: 1.5, Text: "Hello there"},
+			},
+		},
+		{
+			name:     "unsupported provider",
+			provider: recallaigo.TranscriptionProviderGladia,
+			raw:      `{}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid json",
+			provider: recallaigo.TranscriptionProviderDeepgram,
+			raw:      `{"results": [`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := recallaigo.NormalizeTranscript(tt.provider, []byte(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeTranscript() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			got := recallaigo.Utterances(entries)
+			if len(got) != len(tt.want) {
+				t.Fatalf("NormalizeTranscript() returned %d utterances, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("NormalizeTranscript()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
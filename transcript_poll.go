@@ -0,0 +1,88 @@
+package recallaigo
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// TranscriptUpdate is a change of a transcript emitted by PollTranscript.
+//
+// Providers may re-write the most recent entries while a call is in progress, so an update
+// replaces everything from Index onwards: apply it with transcript = append(transcript[:Index], Entries...).
+type TranscriptUpdate struct {
+	// The index of the first new or changed entry.
+	Index int
+	// The entries from Index to the end of the transcript.
+	Entries []TranscriptEntry
+	// The error of a failed fetch. Polling continues after an error.
+	Err error
+}
+
+// PollTranscript fetches the transcript of the bot every interval and emits the new and changed entries.
+// Nothing is emitted while the transcript is unchanged. The channel is closed once ctx is done.
+func (c *BotClient) PollTranscript(ctx context.Context, botID string, interval time.Duration) <-chan TranscriptUpdate {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	updates := make(chan TranscriptUpdate)
+	go func() {
+		defer close(updates)
+
+		ticker := c.client.clock.NewTicker(interval)
+		defer ticker.Stop()
+
+		var previous []TranscriptEntry
+		for {
+			var update *TranscriptUpdate
+			transcript, err := c.GetBotTranscript(ctx, botID)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				update = &TranscriptUpdate{Err: err}
+			default:
+				if i, changed := diffTranscript(previous, transcript); changed {
+					update = &TranscriptUpdate{Index: i, Entries: transcript[i:]}
+					previous = transcript
+				}
+			}
+
+			if update != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case updates <- *update:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+			}
+		}
+	}()
+
+	return updates
+}
+
+// diffTranscript returns the index of the first entry that differs between the transcripts,
+// and whether they differ at all.
+func diffTranscript(previous, current []TranscriptEntry) (int, bool) {
+	n := min(len(previous), len(current))
+	for i := 0; i < n; i++ {
+		if !transcriptEntryEqual(previous[i], current[i]) {
+			return i, true
+		}
+	}
+	return n, len(previous) != len(current)
+}
+
+func transcriptEntryEqual(a, b TranscriptEntry) bool {
+	return a.Speaker == b.Speaker &&
+		a.SpeakerID == b.SpeakerID &&
+		a.Language == b.Language &&
+		slices.Equal(a.Words, b.Words)
+}
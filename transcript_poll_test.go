@@ -0,0 +1,76 @@
+package recallaigo_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestPollTranscript(t *testing.T) {
+	responses := []struct {
+		body   string
+		status int
+	}{
+		{body: `[{"speaker": "Alice", "words": [{"text": "Hello", "start_timestamp": 1}]}]`, status: http.StatusOK},
+		{body: `[{"speaker": "Alice", "words": [{"text": "Hello", "start_timestamp": 1}]}]`, status: http.StatusOK},
+		{body: `[{"speaker": "Alice", "words": [{"text": "Hello", "start_timestamp": 1}]}, {"speaker": "Bob", "words": [{"text": "Hi", "start_timestamp": 2}]}]`, status: http.StatusOK},
+		{body: `{"detail": "Server error"}`, status: http.StatusInternalServerError},
+		{body: `[{"speaker": "Alice", "words": [{"text": "Hello", "start_timestamp": 1}]}, {"speaker": "Bob", "words": [{"text": "Hi,", "start_timestamp": 2}, {"text": "Alice", "start_timestamp": 3}]}]`, status: http.StatusOK},
+	}
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	c := testutil.NewTestClient(func(*http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+		r := responses[min(calls, len(responses)-1)]
+		calls++
+		return testutil.NewStringResponse(r.body, r.status)
+	})
+	client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(c))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := client.Bot.PollTranscript(ctx, "bot_id", time.Millisecond)
+
+	want := []struct {
+		index   int
+		entries int
+		wantErr bool
+	}{
+		{index: 0, entries: 1},
+		{index: 1, entries: 1},
+		{wantErr: true},
+		{index: 1, entries: 1},
+	}
+
+	var transcript []recallaigo.TranscriptEntry
+	for i, w := range want {
+		update := <-updates
+		if (update.Err != nil) != w.wantErr {
+			t.Fatalf("update %d error = %v, wantErr %v", i, update.Err, w.wantErr)
+		}
+		if w.wantErr {
+			continue
+		}
+		if update.Index != w.index || len(update.Entries) != w.entries {
+			t.Fatalf("update %d = {Index: %d, Entries: %d}, want {Index: %d, Entries: %d}", i, update.Index, len(update.Entries), w.index, w.entries)
+		}
+		transcript = append(transcript[:update.Index], update.Entries...)
+	}
+
+	if len(transcript) != 2 || len(transcript[1].Words) != 2 {
+		t.Errorf("applied transcript = %+v, want the re-written second entry", transcript)
+	}
+
+	cancel()
+	for range updates {
+	}
+}
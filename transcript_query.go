@@ -0,0 +1,107 @@
+package recallaigo
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// TranscriptMatch is a keyword hit in a transcript.
+type TranscriptMatch struct {
+	// The index of the matching entry in the searched transcript.
+	EntryIndex int
+	Entry      TranscriptEntry
+	// The index of the first matching word in the entry.
+	WordIndex int
+	// The number of words matched by the query.
+	WordCount int
+	// The start time of the first matching word in seconds.
+	Start float64
+}
+
+// FilterTranscriptBySpeaker returns the entries spoken by any of the named speakers.
+func FilterTranscriptBySpeaker(entries []TranscriptEntry, speakers ...string) []TranscriptEntry {
+	var filtered []TranscriptEntry
+	for _, entry := range entries {
+		if slices.Contains(speakers, entry.Speaker) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// FilterTranscriptByTime returns the entries with words starting within [start, end) seconds,
+// keeping only those words.
+func FilterTranscriptByTime(entries []TranscriptEntry, start, end float64) []TranscriptEntry {
+	var filtered []TranscriptEntry
+	for _, entry := range entries {
+		var words []WordDetail
+		for _, word := range entry.Words {
+			if word.StartTimestamp >= start && word.StartTimestamp < end {
+				words = append(words, word)
+			}
+		}
+		if len(words) > 0 {
+			entry.Words = words
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// SearchTranscript finds the words or phrase in the transcript, ignoring case and punctuation.
+// Phrases only match within a single entry.
+func SearchTranscript(entries []TranscriptEntry, query string) []TranscriptMatch {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = normalizeWord(term)
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var matches []TranscriptMatch
+	for i, entry := range entries {
+		words := make([]string, len(entry.Words))
+		for j, word := range entry.Words {
+			words[j] = normalizeWord(word.Text)
+		}
+
+		for j := 0; j+len(terms) <= len(words); j++ {
+			if slices.Equal(words[j:j+len(terms)], terms) {
+				matches = append(matches, TranscriptMatch{
+					EntryIndex: i,
+					Entry:      entry,
+					WordIndex:  j,
+					WordCount:  len(terms),
+					Start:      entry.Words[j].StartTimestamp,
+				})
+			}
+		}
+	}
+	return matches
+}
+
+// normalizeWord lowercases the word and trims surrounding punctuation.
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// transcriptAfter returns the entries with words starting after the given seconds,
+// keeping only those words.
+func transcriptAfter(entries []TranscriptEntry, after float64) []TranscriptEntry {
+	filtered := make([]TranscriptEntry, 0, len(entries))
+	for _, entry := range entries {
+		i := slices.IndexFunc(entry.Words, func(word WordDetail) bool {
+			return word.StartTimestamp > after
+		})
+		if i < 0 {
+			continue
+		}
+		entry.Words = entry.Words[i:]
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
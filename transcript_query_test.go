@@ -0,0 +1,54 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestTranscriptQueries(t *testing.T) {
+	entries := []recallaigo.TranscriptEntry{
+		newTranscriptEntry("Alice", 1, 0, "Let us talk about pricing."),
+		newTranscriptEntry("Bob", 2, 10, "Pricing is fine, but the pricing page is not"),
+		newTranscriptEntry("Alice", 1, 30, "Next topic"),
+	}
+
+	t.Run("FilterTranscriptBySpeaker", func(t *testing.T) {
+		got := recallaigo.FilterTranscriptBySpeaker(entries, "Alice")
+		if len(got) != 2 {
+			t.Errorf("FilterTranscriptBySpeaker() returned %d entries, want 2", len(got))
+		}
+	})
+
+	t.Run("FilterTranscriptByTime", func(t *testing.T) {
+		got := recallaigo.FilterTranscriptByTime(entries, 3, 12)
+		if len(got) != 2 || len(got[0].Words) != 2 || len(got[1].Words) != 2 {
+			t.Errorf("FilterTranscriptByTime() = %+v, want the last two words of the first entry and the first two of the second", got)
+		}
+	})
+
+	t.Run("SearchTranscript", func(t *testing.T) {
+		tests := []struct {
+			query     string
+			wantStart []float64
+		}{
+			{query: "pricing", wantStart: []float64{4, 10, 15}},
+			{query: "Pricing page", wantStart: []float64{15}},
+			{query: "budget", wantStart: nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.query, func(t *testing.T) {
+				got := recallaigo.SearchTranscript(entries, tt.query)
+				if len(got) != len(tt.wantStart) {
+					t.Fatalf("SearchTranscript() returned %d matches, want %d", len(got), len(tt.wantStart))
+				}
+				for i, match := range got {
+					if match.Start != tt.wantStart[i] {
+						t.Errorf("SearchTranscript()[%d].Start = %v, want %v", i, match.Start, tt.wantStart[i])
+					}
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package recallaigo_test
+
+import (
+	"strings"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// newTranscriptEntry returns an entry with one word per second starting at start.
+func newTranscriptEntry(speaker string, speakerID int, start float64, text string) recallaigo.TranscriptEntry {
+	entry := recallaigo.TranscriptEntry{Speaker: speaker, SpeakerID: speakerID, Language: "en"}
+	for i, word := range strings.Fields(text) {
+		entry.Words = append(entry.Words, recallaigo.WordDetail{
+			Text:           word,
+			StartTimestamp: start + float64(i),
+			EndTimestamp:   start + float64(i) + 1,
+			Language:       "en",
+			Confidence:     0.9,
+		})
+	}
+	return entry
+}
+
+func TestRenderTranscriptText(t *testing.T) {
+	entries := []recallaigo.TranscriptEntry{
+		newTranscriptEntry("Alice", 1, 192, "Hello everyone"),
+		newTranscriptEntry("Alice", 1, 194, "let us start"),
+		newTranscriptEntry("", 2, 3700, "Sounds good"),
+	}
+
+	tests := []struct {
+		name string
+		opts recallaigo.TextRenderOptions
+		want string
+	}{
+		{
+			name: "renders turns",
+			want: "Alice: Hello everyone\nAlice: let us start\nSpeaker 2: Sounds good\n",
+		},
+		{
+			name: "renders timestamps and merges turns",
+			opts: recallaigo.TextRenderOptions{Timestamps: true, MergeConsecutive: true},
+			want: "[00:03:12] Alice: Hello everyone let us start\n[01:01:40] Speaker 2: Sounds good\n",
+		},
+		{
+			name: "wraps lines",
+			opts: recallaigo.TextRenderOptions{WrapWidth: 20, MergeConsecutive: true},
+			want: "Alice: Hello\n       everyone let\n       us start\nSpeaker 2: Sounds\n           good\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := recallaigo.RenderTranscriptText(&b, entries, tt.opts); err != nil {
+				t.Fatalf("RenderTranscriptText() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("RenderTranscriptText() = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteTranscriptJSONL(t *testing.T) {
+	entries := []recallaigo.TranscriptEntry{
+		newTranscriptEntry("Alice", 1, 10, "Hello everyone"),
+		{Speaker: "Bob", SpeakerID: 2},
+		newTranscriptEntry("Bob", 2, 12.5, "Hi"),
+	}
+
+	var b strings.Builder
+	if err := recallaigo.WriteTranscriptJSONL(&b, entries); err != nil {
+		t.Fatalf("WriteTranscriptJSONL() error = %v", err)
+	}
+
+	want := `{"speaker":"Alice","speaker_id":1,"start":10,"end":12,"text":"Hello everyone","confidence":0.9}
+{"speaker":"Bob","speaker_id":2,"start":12.5,"end":13.5,"text":"Hi","confidence":0.9}
+`
+	if b.String() != want {
+		t.Errorf("WriteTranscriptJSONL() = %s, want %s", b.String(), want)
+	}
+}
+
+func TestRenderTranscriptSRT(t *testing.T) {
+	entries := []recallaigo.TranscriptEntry{
+		newTranscriptEntry("Alice", 1, 10, "Hello everyone"),
+		{Speaker: "Bob", SpeakerID: 2},
+		newTranscriptEntry("", 2, 3661.25, "Hi"),
+	}
+
+	var b strings.Builder
+	if err := recallaigo.RenderTranscriptSRT(&b, entries); err != nil {
+		t.Fatalf("RenderTranscriptSRT() error = %v", err)
+	}
+
+	want := `1
+00:00:10,000 --> 00:00:12,000
+Alice: Hello everyone
+
+2
+01:01:01,250 --> 01:01:02,250
+Speaker 2: Hi
+
+`
+	if b.String() != want {
+		t.Errorf("RenderTranscriptSRT() = %q, want %q", b.String(), want)
+	}
+}
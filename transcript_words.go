@@ -0,0 +1,36 @@
+package recallaigo
+
+import "iter"
+
+// Transcript is the transcript of a bot, e.g. as returned by GetBotTranscript.
+// Any []TranscriptEntry can be used as a Transcript: recallaigo.Transcript(entries).Words().
+type Transcript []TranscriptEntry
+
+// TranscriptWord is a word of a transcript together with the speaker of its entry.
+type TranscriptWord struct {
+	WordDetail
+	Speaker   string
+	SpeakerID int
+	// The index of the entry of the word in the transcript.
+	Entry int
+}
+
+// Words returns an iterator over the words of the transcript in order. Unlike flattening the
+// entries into a slice, it allocates nothing, so statistics can be computed over very large
+// transcripts:
+//
+//	for w := range transcript.Words() {
+//		total += w.EndTimestamp - w.StartTimestamp
+//	}
+func (t Transcript) Words() iter.Seq[TranscriptWord] {
+	return func(yield func(TranscriptWord) bool) {
+		for i := range t {
+			entry := &t[i]
+			for _, word := range entry.Words {
+				if !yield(TranscriptWord{WordDetail: word, Speaker: entry.Speaker, SpeakerID: entry.SpeakerID, Entry: i}) {
+					return
+				}
+			}
+		}
+	}
+}
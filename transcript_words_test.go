@@ -0,0 +1,64 @@
+package recallaigo_test
+
+import (
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+func TestTranscriptWords(t *testing.T) {
+	transcript := recallaigo.Transcript{
+		newTranscriptEntry("Alice", 1, 0, "Hello everyone"),
+		{Speaker: "Nobody", SpeakerID: 3},
+		newTranscriptEntry("Bob", 2, 5, "Hi Alice"),
+	}
+
+	var got []recallaigo.TranscriptWord
+	for w := range transcript.Words() {
+		got = append(got, w)
+	}
+
+	want := []struct {
+		text    string
+		speaker string
+		entry   int
+	}{
+		{"Hello", "Alice", 0},
+		{"everyone", "Alice", 0},
+		{"Hi", "Bob", 2},
+		{"Alice", "Bob", 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Words() yielded %d words, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Text != w.text || got[i].Speaker != w.speaker || got[i].Entry != w.entry {
+			t.Errorf("word %d = %q by %q in entry %d, want %q by %q in entry %d", i, got[i].Text, got[i].Speaker, got[i].Entry, w.text, w.speaker, w.entry)
+		}
+	}
+
+	t.Run("stops early", func(t *testing.T) {
+		var n int
+		for range transcript.Words() {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+		if n != 3 {
+			t.Errorf("iterated %d words, want 3", n)
+		}
+	})
+
+	t.Run("does not allocate", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			var total float64
+			for w := range transcript.Words() {
+				total += w.EndTimestamp - w.StartTimestamp
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("Words() allocated %v times per run, want 0", allocs)
+		}
+	})
+}
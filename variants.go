@@ -0,0 +1,33 @@
+package recallaigo
+
+import "cmp"
+
+// WithDefaultVariants sets the variants of the bots created by the client for the platforms the
+// request sets none for, e.g. web_4_core for all Zoom bots.
+func WithDefaultVariants(defaults Variant) ClientOption {
+	return func(c *Client) {
+		c.defaultVariants = defaults
+	}
+}
+
+// withDefaultVariants returns the request with the default variants of the client merged into
+// its variants. The request of the caller is not modified.
+func (c *Client) withDefaultVariants(request *CreateBotRequest) *CreateBotRequest {
+	if c.defaultVariants == (Variant{}) {
+		return request
+	}
+
+	variant := c.defaultVariants
+	if v := request.Variant; v != nil {
+		variant = Variant{
+			Zoom:               cmp.Or(v.Zoom, variant.Zoom),
+			GoogleMeet:         cmp.Or(v.GoogleMeet, variant.GoogleMeet),
+			MicrosoftTeams:     cmp.Or(v.MicrosoftTeams, variant.MicrosoftTeams),
+			MicrosoftTeamsLive: cmp.Or(v.MicrosoftTeamsLive, variant.MicrosoftTeamsLive),
+		}
+	}
+
+	r := *request
+	r.Variant = &variant
+	return &r
+}
@@ -0,0 +1,73 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestWithDefaultVariants(t *testing.T) {
+	defaults := recallaigo.Variant{Zoom: recallaigo.VariantWeb4Core, MicrosoftTeams: recallaigo.VariantWeb}
+
+	tests := []struct {
+		name     string
+		defaults recallaigo.Variant
+		variant  *recallaigo.Variant
+		want     map[string]string
+	}{
+		{
+			name:     "sets the defaults",
+			defaults: defaults,
+			want:     map[string]string{"zoom": "web_4_core", "microsoft_teams": "web"},
+		},
+		{
+			name:     "keeps the variants of the request",
+			defaults: defaults,
+			variant:  &recallaigo.Variant{Zoom: recallaigo.VariantNative, GoogleMeet: recallaigo.VariantWeb},
+			want:     map[string]string{"zoom": "native", "google_meet": "web", "microsoft_teams": "web"},
+		},
+		{
+			name: "without defaults",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body struct {
+				Variants map[string]string `json:"variants"`
+			}
+			router := testutil.NewRouter()
+			router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+				}
+				return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+			})
+			client := recallaigo.NewClient("some_token",
+				recallaigo.WithHTTPClient(router.Client()),
+				recallaigo.WithDefaultVariants(tt.defaults),
+			)
+
+			var original recallaigo.Variant
+			if tt.variant != nil {
+				original = *tt.variant
+			}
+			request := &recallaigo.CreateBotRequest{MeetingURL: "https://zoom.us/j/123", BotName: "Test Bot", Variant: tt.variant}
+			if _, err := client.Bot.CreateBot(context.Background(), request); err != nil {
+				t.Fatalf("CreateBot() error = %v", err)
+			}
+
+			if !maps.Equal(body.Variants, tt.want) {
+				t.Errorf("sent variants = %v, want %v", body.Variants, tt.want)
+			}
+			if request.Variant != tt.variant || (tt.variant != nil && *tt.variant != original) {
+				t.Errorf("CreateBot() modified the variants of the request")
+			}
+		})
+	}
+}
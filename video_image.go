@@ -0,0 +1,132 @@
+package recallaigo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png" // Register the PNG decoder for NewOutputVideoRequestFromReader.
+	"io"
+	"os"
+)
+
+// The dimensions of the images recommended for OutputVideo and StartScreenshare. Images in
+// other formats or sizes may be shown as a blank video without an error.
+const (
+	OutputVideoWidth  = 640
+	OutputVideoHeight = 360
+)
+
+// outputVideoQuality is the JPEG quality of the images of NewOutputVideoRequest.
+const outputVideoQuality = 85
+
+// NewOutputVideoRequest returns an OutputVideoRequest showing img as a 640x360 JPEG image.
+// The image is scaled to fit, keeping its aspect ratio, and centered between black bars.
+// Transparent pixels are shown over black.
+func NewOutputVideoRequest(img image.Image) (*OutputVideoRequest, error) {
+	frame := letterbox(img, OutputVideoWidth, OutputVideoHeight)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, frame, &jpeg.Options{Quality: outputVideoQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode JPEG: %w", err)
+	}
+
+	return &OutputVideoRequest{
+		Kind:    OutputVideoKindJpeg,
+		B64Data: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+// The limits of the images decoded by NewOutputVideoRequestFromFile and the screenshare helpers,
+// so that huge or malicious files cannot exhaust memory.
+const (
+	maxImageFileSize = 32 << 20
+	maxImagePixels   = 50_000_000
+)
+
+// NewOutputVideoRequestFromFile is like NewOutputVideoRequest for a PNG or JPEG file.
+func NewOutputVideoRequestFromFile(name string) (*OutputVideoRequest, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image: %w", err)
+	}
+	defer f.Close()
+
+	return NewOutputVideoRequestFromReader(f)
+}
+
+// NewOutputVideoRequestFromReader is like NewOutputVideoRequest for a PNG or JPEG image read from r.
+// Files over 32 MiB and images over 50 megapixels are rejected.
+func NewOutputVideoRequestFromReader(r io.Reader) (*OutputVideoRequest, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxImageFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > maxImageFileSize {
+		return nil, fmt.Errorf("image is larger than %d MiB", maxImageFileSize>>20)
+	}
+
+	// Check the dimensions before decoding, which allocates all pixels.
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("image of %dx%d pixels is too large", config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return NewOutputVideoRequest(img)
+}
+
+// letterbox returns an opaque width x height image with img scaled to fit in its center,
+// on a black background.
+func letterbox(img image.Image, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 3; i < len(dst.Pix); i += 4 {
+		dst.Pix[i] = 0xff
+	}
+
+	src := img.Bounds()
+	if src.Empty() {
+		return dst
+	}
+
+	// Fit the image, keeping its aspect ratio.
+	w, h := width, src.Dy()*width/src.Dx()
+	if h > height {
+		w, h = src.Dx()*height/src.Dy(), height
+	}
+	w, h = max(w, 1), max(h, 1)
+	offset := image.Pt((width-w)/2, (height-h)/2)
+
+	// Every pixel is the average of the source pixels it covers, so that downscaled images
+	// do not alias. Pixels are premultiplied, so the average is composed over black as is.
+	for y := 0; y < h; y++ {
+		y0 := src.Min.Y + y*src.Dy()/h
+		y1 := max(src.Min.Y+(y+1)*src.Dy()/h, y0+1)
+		for x := 0; x < w; x++ {
+			x0 := src.Min.X + x*src.Dx()/w
+			x1 := max(src.Min.X+(x+1)*src.Dx()/w, x0+1)
+
+			var r, g, b, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, _ := img.At(sx, sy).RGBA()
+					r, g, b = r+uint64(pr), g+uint64(pg), b+uint64(pb)
+					n++
+				}
+			}
+
+			i := dst.PixOffset(offset.X+x, offset.Y+y)
+			dst.Pix[i] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(b / n >> 8)
+		}
+	}
+	return dst
+}
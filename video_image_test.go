@@ -0,0 +1,198 @@
+package recallaigo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func uniformImage(width, height int, c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+// decodeFrame decodes the JPEG image of a request.
+func decodeFrame(t *testing.T, request *recallaigo.OutputVideoRequest) image.Image {
+	t.Helper()
+
+	if request.Kind != recallaigo.OutputVideoKindJpeg {
+		t.Fatalf("Kind = %q, want %q", request.Kind, recallaigo.OutputVideoKindJpeg)
+	}
+	data, err := base64.StdEncoding.DecodeString(request.B64Data)
+	if err != nil {
+		t.Fatalf("failed to decode base64: %v", err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("failed to decode JPEG: %v", err)
+	}
+	if img.Bounds() != image.Rect(0, 0, recallaigo.OutputVideoWidth, recallaigo.OutputVideoHeight) {
+		t.Fatalf("bounds = %v, want 640x360", img.Bounds())
+	}
+	return img
+}
+
+// near reports whether the colors differ by less than the artifacts of JPEG compression.
+func near(a, b color.Color) bool {
+	ar, ag, ab, _ := a.RGBA()
+	br, bg, bb, _ := b.RGBA()
+	diff := func(x, y uint32) bool {
+		return int(x>>8)-int(y>>8) < 24 && int(y>>8)-int(x>>8) < 24
+	}
+	return diff(ar, br) && diff(ag, bg) && diff(ab, bb)
+}
+
+func TestNewOutputVideoRequest(t *testing.T) {
+	red := color.RGBA{R: 0xff, A: 0xff}
+	black := color.RGBA{A: 0xff}
+
+	tests := []struct {
+		name string
+		img  image.Image
+		want map[image.Point]color.Color
+	}{
+		{
+			name: "scales 16:9 images",
+			img:  uniformImage(1920, 1080, red),
+			want: map[image.Point]color.Color{{0, 0}: red, {320, 180}: red, {639, 359}: red},
+		},
+		{
+			name: "adds bars to square images",
+			img:  uniformImage(100, 100, red),
+			want: map[image.Point]color.Color{{0, 0}: black, {100, 180}: black, {320, 180}: red, {480, 180}: red, {639, 180}: black},
+		},
+		{
+			name: "adds bars to wide images",
+			img:  uniformImage(3000, 500, red),
+			want: map[image.Point]color.Color{{320, 0}: black, {320, 180}: red, {320, 359}: black},
+		},
+		{
+			name: "shows transparency as black",
+			img:  uniformImage(640, 360, color.RGBA{}),
+			want: map[image.Point]color.Color{{320, 180}: black},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := recallaigo.NewOutputVideoRequest(tt.img)
+			if err != nil {
+				t.Fatalf("NewOutputVideoRequest() error = %v", err)
+			}
+
+			frame := decodeFrame(t, request)
+			for p, want := range tt.want {
+				if got := frame.At(p.X, p.Y); !near(got, want) {
+					t.Errorf("pixel %v = %v, want %v", p, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewOutputVideoRequestFromFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "slide.png")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, uniformImage(320, 180, color.RGBA{B: 0xff, A: 0xff})); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	request, err := recallaigo.NewOutputVideoRequestFromFile(name)
+	if err != nil {
+		t.Fatalf("NewOutputVideoRequestFromFile() error = %v", err)
+	}
+	if got := decodeFrame(t, request).At(10, 10); !near(got, color.RGBA{B: 0xff, A: 0xff}) {
+		t.Errorf("pixel = %v, want blue", got)
+	}
+
+	if _, err := recallaigo.NewOutputVideoRequestFromFile("test_data/bot.json"); err == nil {
+		t.Error("NewOutputVideoRequestFromFile() error = nil for a file that is not an image")
+	}
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestStartScreenshareFromReader(t *testing.T) {
+	// A PNG whose header claims 10000x10000 pixels.
+	huge := encodePNG(t, uniformImage(1, 1, color.Black))
+	binary.BigEndian.PutUint32(huge[16:], 10000)
+	binary.BigEndian.PutUint32(huge[20:], 10000)
+	binary.BigEndian.PutUint32(huge[29:], crc32.ChecksumIEEE(huge[12:29]))
+
+	tests := []struct {
+		name    string
+		src     io.Reader
+		wantErr bool
+	}{
+		{name: "shares images", src: bytes.NewReader(encodePNG(t, uniformImage(1280, 720, color.White)))},
+		{name: "rejects large files", src: io.LimitReader(zeroReader{}, 33<<20), wantErr: true},
+		{name: "rejects large images", src: bytes.NewReader(huge), wantErr: true},
+		{name: "rejects other formats", src: bytes.NewReader([]byte("GIF89a")), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *recallaigo.OutputVideoRequest
+			router := testutil.NewRouter()
+			router.Handle("POST /api/v1/bot/{id}/output_screenshare", func(req *http.Request) *http.Response {
+				got = &recallaigo.OutputVideoRequest{}
+				json.NewDecoder(req.Body).Decode(got)
+				return testutil.NewFileResponse(t, "test_data/start_screenshare.json", http.StatusOK)
+			})
+			client := recallaigo.NewClient("some_token", recallaigo.WithHTTPClient(router.Client()))
+
+			_, err := client.Bot.StartScreenshareFromReader(context.Background(), "bot_id", tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StartScreenshareFromReader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if got != nil {
+					t.Error("StartScreenshareFromReader() sent a request despite the error")
+				}
+				return
+			}
+			if frame := decodeFrame(t, got); !near(frame.At(320, 180), color.White) {
+				t.Errorf("pixel = %v, want white", frame.At(320, 180))
+			}
+		})
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
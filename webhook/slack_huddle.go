@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+// EventSlackHuddleDetected is the type of the events sent when a Slack huddle observer detects
+// a huddle and decides whether to join it.
+const EventSlackHuddleDetected = "slack_huddle_observer.huddle_detected"
+
+// SlackHuddleEvent is the data of an EventSlackHuddleDetected event.
+type SlackHuddleEvent struct {
+	// The Slack huddle observer bot.
+	Bot struct {
+		ID       string              `json:"id"`
+		Metadata recallaigo.Metadata `json:"metadata"`
+	} `json:"bot"`
+	Huddle recallaigo.ObservedHuddle `json:"huddle"`
+}
+
+// SlackHuddle decodes the data of an EventSlackHuddleDetected event.
+func (e *Event) SlackHuddle() (*SlackHuddleEvent, error) {
+	if e.Event != EventSlackHuddleDetected {
+		return nil, fmt.Errorf("event %q is not a %s event", e.Event, EventSlackHuddleDetected)
+	}
+
+	var data SlackHuddleEvent
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode event data: %w", err)
+	}
+	return &data, nil
+}
@@ -0,0 +1,255 @@
+// Package webhook receives the webhooks of Recall.ai, which are signed with Svix.
+//
+// A Verifier checks the signature of each request before its event is handled:
+//
+//	verifier, err := webhook.NewVerifier(os.Getenv("RECALLAI_WEBHOOK_SECRET"))
+//	if err != nil {
+//		return err
+//	}
+//	http.Handle("/webhooks/recall", verifier.Handler(func(ctx context.Context, event *webhook.Event) error {
+//		log.Printf("%s for bot %s", event.Event, event.BotID())
+//		return nil
+//	}))
+//
+// With HandlerOptions.Logger, the handler logs every request without its payload, for
+// operational visibility under data-handling policies.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+)
+
+var (
+	// ErrInvalidSignature is returned when no signature of the request matches the secrets.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrTimestampOutOfRange is returned when the request was signed too long ago, or in the future,
+	// which indicates a replayed request.
+	ErrTimestampOutOfRange = errors.New("webhook timestamp out of range")
+	// ErrMissingHeaders is returned when the request has no Svix signature headers.
+	ErrMissingHeaders = errors.New("missing webhook signature headers")
+)
+
+// secretPrefix is the prefix of the secrets shown in the Recall.ai dashboard.
+const secretPrefix = "whsec_"
+
+// defaultTolerance is how far the timestamp of a request may be from now.
+const defaultTolerance = 5 * time.Minute
+
+// maxBodySize is the largest request body read by Handler.
+const maxBodySize = 10 << 20
+
+// Event is a webhook event, e.g. a status change of a bot.
+type Event struct {
+	// The type of the event, e.g. "bot.status_change" or "bot.done".
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// BotID returns the ID of the bot the event is about, or an empty string if it is not about a bot.
+func (e *Event) BotID() string {
+	var data struct {
+		BotID string `json:"bot_id"`
+		Bot   struct {
+			ID string `json:"id"`
+		} `json:"bot"`
+	}
+	if err := json.Unmarshal(e.Data, &data); err != nil {
+		return ""
+	}
+	if data.BotID != "" {
+		return data.BotID
+	}
+	return data.Bot.ID
+}
+
+// VerifierOptions configures a Verifier.
+type VerifierOptions struct {
+	// How far the timestamp of a request may be from now. Defaults to 5 minutes.
+	Tolerance time.Duration
+	// The clock the timestamps are compared to. Defaults to recallaigo.SystemClock.
+	Clock recallaigo.Clock
+	// Secrets accepted besides the secret of NewVerifier, e.g. the previous secret while
+	// a rotation is rolled out, so that no event is dropped.
+	PreviousSecrets []string
+}
+
+// Verifier checks the Svix signatures of webhook requests.
+type Verifier struct {
+	// The keys of the accepted secrets, the current one first.
+	keys      [][]byte
+	tolerance time.Duration
+	clock     recallaigo.Clock
+}
+
+// NewVerifier creates a Verifier for the signing secret of the webhook endpoint, e.g. "whsec_...".
+// Requests signed with VerifierOptions.PreviousSecrets are accepted as well.
+func NewVerifier(secret string, opts ...VerifierOptions) (*Verifier, error) {
+	var opt VerifierOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	var keys [][]byte
+	for _, secret := range append([]string{secret}, opt.PreviousSecrets...) {
+		key, err := decodeSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if opt.Tolerance <= 0 {
+		opt.Tolerance = defaultTolerance
+	}
+	if opt.Clock == nil {
+		opt.Clock = recallaigo.SystemClock{}
+	}
+
+	return &Verifier{keys: keys, tolerance: opt.Tolerance, clock: opt.Clock}, nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, secretPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode webhook secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("empty webhook secret")
+	}
+	return key, nil
+}
+
+// Verify checks the signature headers of a request against its body.
+func (v *Verifier) Verify(header http.Header, body []byte) error {
+	id, timestamp, signatures := svixHeader(header, "id"), svixHeader(header, "timestamp"), svixHeader(header, "signature")
+	if id == "" || timestamp == "" || signatures == "" {
+		return ErrMissingHeaders
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrTimestampOutOfRange, timestamp)
+	}
+	if age := v.clock.Now().Sub(time.Unix(seconds, 0)); age > v.tolerance || age < -v.tolerance {
+		return ErrTimestampOutOfRange
+	}
+
+	// The header holds space-separated signatures, e.g. "v1,abc= v1,def=", one per active secret
+	// of the sender, which may match any secret accepted here.
+	for _, key := range v.keys {
+		want := sign(key, id, timestamp, body)
+		for _, signature := range strings.Fields(signatures) {
+			version, sig, ok := strings.Cut(signature, ",")
+			if !ok || version != "v1" {
+				continue
+			}
+			if got, err := base64.StdEncoding.DecodeString(sig); err == nil && hmac.Equal(got, want) {
+				return nil
+			}
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// Sign returns the signature header value of a body with the current secret, e.g. to send
+// signed requests in tests.
+func (v *Verifier) Sign(id string, timestamp time.Time, body []byte) string {
+	return "v1," + base64.StdEncoding.EncodeToString(sign(v.keys[0], id, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+func sign(key []byte, id, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// svixHeader returns a signature header, which is sent with either the "svix-" or the
+// Standard Webhooks "webhook-" prefix.
+func svixHeader(header http.Header, name string) string {
+	if value := header.Get("svix-" + name); value != "" {
+		return value
+	}
+	return header.Get("webhook-" + name)
+}
+
+// HandlerOptions configures the handler of Handler.
+type HandlerOptions struct {
+	// Logs every request with the type and bot of its event, its message ID, response status and
+	// duration, but never its payload, such as transcript text or chat messages. Errors returned
+	// by the handler function are not logged either, as they may quote the payload. Nil disables logging.
+	Logger *slog.Logger
+}
+
+// Handler returns an http.Handler verifying each request and calling fn with its event.
+// Requests with an invalid signature are answered with 401 Unauthorized, and requests whose
+// handling failed with 500 Internal Server Error, so that Recall.ai retries them.
+func (v *Verifier) Handler(fn func(ctx context.Context, event *Event) error, opts ...HandlerOptions) http.Handler {
+	var opt HandlerOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := v.clock.Now()
+		var event Event
+		status, reason := v.handle(r, w, fn, &event)
+		if status != http.StatusNoContent {
+			http.Error(w, reason, status)
+		} else {
+			w.WriteHeader(status)
+		}
+
+		if opt.Logger != nil {
+			level := slog.LevelInfo
+			if status != http.StatusNoContent {
+				level = slog.LevelWarn
+			}
+			opt.Logger.LogAttrs(r.Context(), level, "webhook request",
+				slog.String("event", event.Event),
+				slog.String("bot_id", event.BotID()),
+				slog.String("message_id", svixHeader(r.Header, "id")),
+				slog.Int("status", status),
+				slog.String("reason", reason),
+				slog.Duration("duration", v.clock.Now().Sub(start)),
+			)
+		}
+	})
+}
+
+// handle verifies the request and calls fn with its event, returning the status of the response
+// and, for errors, its reason. The reason never contains the payload of the event.
+func (v *Verifier) handle(r *http.Request, w http.ResponseWriter, fn func(ctx context.Context, event *Event) error, event *Event) (int, string) {
+	if r.Method != http.MethodPost {
+		return http.StatusMethodNotAllowed, "method not allowed"
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		return http.StatusBadRequest, "failed to read body"
+	}
+	if err := v.Verify(r.Header, body); err != nil {
+		return http.StatusUnauthorized, err.Error()
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return http.StatusBadRequest, "invalid event"
+	}
+	if err := fn(r.Context(), event); err != nil {
+		return http.StatusInternalServerError, "failed to handle event"
+	}
+	return http.StatusNoContent, ""
+}
@@ -0,0 +1,293 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/recalltest"
+	"github.com/harrison-peng/recallai-go/webhook"
+)
+
+// The example of the Svix documentation.
+const (
+	testSecret    = "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+	testID        = "msg_p5jXN8AQM9LWM0D4loKWxJek"
+	testTimestamp = "1614265330"
+	testBody      = `{"test": 2432232314}`
+	testSignature = "v1,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE="
+)
+
+func newTestVerifier(t *testing.T, now time.Time) *webhook.Verifier {
+	t.Helper()
+
+	v, err := webhook.NewVerifier(testSecret, webhook.VerifierOptions{Clock: recalltest.NewFakeClock(now)})
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+	return v
+}
+
+func TestVerify(t *testing.T) {
+	signedAt := time.Unix(1614265330, 0)
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		header  map[string]string
+		body    string
+		wantErr error
+	}{
+		{
+			name:   "accepts a valid signature",
+			now:    signedAt,
+			header: map[string]string{"svix-id": testID, "svix-timestamp": testTimestamp, "svix-signature": testSignature},
+			body:   testBody,
+		},
+		{
+			name:   "accepts one of several signatures",
+			now:    signedAt.Add(time.Minute),
+			header: map[string]string{"svix-id": testID, "svix-timestamp": testTimestamp, "svix-signature": "v1,bm90IGl0 " + testSignature},
+			body:   testBody,
+		},
+		{
+			name:   "accepts Standard Webhooks headers",
+			now:    signedAt,
+			header: map[string]string{"webhook-id": testID, "webhook-timestamp": testTimestamp, "webhook-signature": testSignature},
+			body:   testBody,
+		},
+		{
+			name:    "rejects a modified body",
+			now:     signedAt,
+			header:  map[string]string{"svix-id": testID, "svix-timestamp": testTimestamp, "svix-signature": testSignature},
+			body:    `{"test": 1}`,
+			wantErr: webhook.ErrInvalidSignature,
+		},
+		{
+			name:    "rejects an old timestamp",
+			now:     signedAt.Add(6 * time.Minute),
+			header:  map[string]string{"svix-id": testID, "svix-timestamp": testTimestamp, "svix-signature": testSignature},
+			body:    testBody,
+			wantErr: webhook.ErrTimestampOutOfRange,
+		},
+		{
+			name:    "rejects missing headers",
+			now:     signedAt,
+			header:  map[string]string{"svix-id": testID},
+			body:    testBody,
+			wantErr: webhook.ErrMissingHeaders,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := make(http.Header)
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+
+			err := newTestVerifier(t, tt.now).Verify(header, []byte(tt.body))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyRotation(t *testing.T) {
+	const newSecret = "whsec_bmV3IHNlY3JldCBvZiB0aGUgZW5kcG9pbnQ="
+	clock := recalltest.NewFakeClock(time.Unix(1614265330, 0))
+
+	rotating, err := webhook.NewVerifier(newSecret, webhook.VerifierOptions{Clock: clock, PreviousSecrets: []string{testSecret}})
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+	old := newTestVerifier(t, clock.Now())
+
+	header := make(http.Header)
+	header.Set("svix-id", testID)
+	header.Set("svix-timestamp", testTimestamp)
+
+	// Events signed with the previous secret are still accepted.
+	header.Set("svix-signature", testSignature)
+	if err := rotating.Verify(header, []byte(testBody)); err != nil {
+		t.Errorf("Verify() of the previous secret error = %v", err)
+	}
+
+	// Events are signed with the new secret.
+	signature := rotating.Sign(testID, clock.Now(), []byte(testBody))
+	if signature == testSignature {
+		t.Fatal("Sign() used the previous secret")
+	}
+	header.Set("svix-signature", signature)
+	if err := rotating.Verify(header, []byte(testBody)); err != nil {
+		t.Errorf("Verify() of the new secret error = %v", err)
+	}
+	if err := old.Verify(header, []byte(testBody)); !errors.Is(err, webhook.ErrInvalidSignature) {
+		t.Errorf("Verify() without the new secret error = %v, want %v", err, webhook.ErrInvalidSignature)
+	}
+
+	if _, err := webhook.NewVerifier(newSecret, webhook.VerifierOptions{PreviousSecrets: []string{"whsec_!"}}); err == nil {
+		t.Error("NewVerifier() error = nil for an invalid previous secret")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Now()
+	verifier := newTestVerifier(t, now)
+	body := `{"event": "bot.status_change", "data": {"bot_id": "some_id", "status": {"code": "done"}}}`
+
+	tests := []struct {
+		name       string
+		signature  string
+		handlerErr error
+		wantStatus int
+		wantBotID  string
+	}{
+		{
+			name:       "handles verified events",
+			signature:  verifier.Sign("msg_1", now, []byte(body)),
+			wantStatus: http.StatusNoContent,
+			wantBotID:  "some_id",
+		},
+		{
+			name:       "rejects unverified events",
+			signature:  "v1,bm90IGl0",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "fails when the handler fails",
+			signature:  verifier.Sign("msg_1", now, []byte(body)),
+			handlerErr: errors.New("some error"),
+			wantStatus: http.StatusInternalServerError,
+			wantBotID:  "some_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var botID string
+			handler := verifier.Handler(func(ctx context.Context, event *webhook.Event) error {
+				botID = event.BotID()
+				return tt.handlerErr
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			req.Header.Set("svix-id", "msg_1")
+			req.Header.Set("svix-timestamp", strconv.FormatInt(now.Unix(), 10))
+			req.Header.Set("svix-signature", tt.signature)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if botID != tt.wantBotID {
+				t.Errorf("BotID() = %q, want %q", botID, tt.wantBotID)
+			}
+		})
+	}
+}
+
+func TestHandlerLogging(t *testing.T) {
+	now := time.Now()
+	verifier := newTestVerifier(t, now)
+	body := `{"event": "transcript.data", "data": {"bot": {"id": "some_id"}, "data": {"words": [{"text": "my secret diagnosis"}]}}}`
+
+	tests := []struct {
+		name       string
+		signature  string
+		handlerErr error
+		want       []string
+	}{
+		{
+			name:      "logs handled events",
+			signature: verifier.Sign("msg_1", now, []byte(body)),
+			want:      []string{`"level":"INFO"`, `"event":"transcript.data"`, `"bot_id":"some_id"`, `"message_id":"msg_1"`, `"status":204`, `"duration"`},
+		},
+		{
+			name:      "logs rejected events",
+			signature: "v1,bm90IGl0",
+			want:      []string{`"level":"WARN"`, `"status":401`, `"reason":"invalid webhook signature"`},
+		},
+		{
+			name:       "does not log handler errors",
+			signature:  verifier.Sign("msg_1", now, []byte(body)),
+			handlerErr: errors.New("cannot store my secret diagnosis"),
+			want:       []string{`"level":"WARN"`, `"status":500`, `"reason":"failed to handle event"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs strings.Builder
+			handler := verifier.Handler(func(ctx context.Context, event *webhook.Event) error {
+				return tt.handlerErr
+			}, webhook.HandlerOptions{Logger: slog.New(slog.NewJSONHandler(&logs, nil))})
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			req.Header.Set("svix-id", "msg_1")
+			req.Header.Set("svix-timestamp", strconv.FormatInt(now.Unix(), 10))
+			req.Header.Set("svix-signature", tt.signature)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			for _, want := range tt.want {
+				if !strings.Contains(logs.String(), want) {
+					t.Errorf("logs do not contain %s: %s", want, logs.String())
+				}
+			}
+			if strings.Contains(logs.String(), "diagnosis") {
+				t.Errorf("logs contain the payload: %s", logs.String())
+			}
+		})
+	}
+}
+
+func TestEventSlackHuddle(t *testing.T) {
+	event := &webhook.Event{
+		Event: webhook.EventSlackHuddleDetected,
+		Data: []byte(`{
+			"bot": {"id": "observer_id", "metadata": {"team": "sales"}},
+			"huddle": {
+				"slack_huddle_id": "H123",
+				"slack_channel_id": "C456",
+				"is_private": true,
+				"detected_at": "2025-03-18T10:13:10.433Z",
+				"join_decision": "asked_to_join"
+			}
+		}`),
+	}
+
+	got, err := event.SlackHuddle()
+	if err != nil {
+		t.Fatalf("SlackHuddle() error = %v", err)
+	}
+	if got.Bot.ID != "observer_id" || event.BotID() != "observer_id" {
+		t.Errorf("SlackHuddle() bot ID = %q, BotID() = %q, want observer_id", got.Bot.ID, event.BotID())
+	}
+	if team, _ := got.Bot.Metadata.String("team"); team != "sales" {
+		t.Errorf("SlackHuddle() bot metadata team = %q, want sales", team)
+	}
+	huddle := got.Huddle
+	if huddle.SlackHuddleID != "H123" || huddle.SlackChannelID != "C456" || !huddle.IsPrivate {
+		t.Errorf("SlackHuddle() huddle = %+v", huddle)
+	}
+	if huddle.JoinDecision != recallaigo.HuddleAskedToJoin {
+		t.Errorf("SlackHuddle() join decision = %q, want %q", huddle.JoinDecision, recallaigo.HuddleAskedToJoin)
+	}
+	if want := time.Date(2025, 3, 18, 10, 13, 10, 433000000, time.UTC); !huddle.DetectedAt.Equal(want) {
+		t.Errorf("SlackHuddle() detected at = %v, want %v", huddle.DetectedAt, want)
+	}
+
+	if _, err := (&webhook.Event{Event: "bot.status_change", Data: []byte(`{}`)}).SlackHuddle(); err == nil {
+		t.Error("SlackHuddle() of another event succeeded")
+	}
+}
@@ -0,0 +1,62 @@
+package recallaigo
+
+import (
+	"context"
+	"fmt"
+)
+
+// ZoomTokenProvider supplies the Zoom tokens of a bot when it is created or its schedule is
+// updated, e.g. by signing short-lived join token and ZAK URLs for the meeting of the request.
+type ZoomTokenProvider interface {
+	// ZoomTokens returns the Zoom parameters of the bot. Empty fields keep the value of the request.
+	ZoomTokens(ctx context.Context, request *CreateBotRequest) (*Zoom, error)
+}
+
+// ZoomTokenFunc adapts a function to a ZoomTokenProvider.
+type ZoomTokenFunc func(ctx context.Context, request *CreateBotRequest) (*Zoom, error)
+
+func (f ZoomTokenFunc) ZoomTokens(ctx context.Context, request *CreateBotRequest) (*Zoom, error) {
+	return f(ctx, request)
+}
+
+// WithZoomTokens sets the Zoom parameters of bots created or updated for Zoom meetings from
+// the provider, instead of static strings in the Zoom field of every request.
+func WithZoomTokens(provider ZoomTokenProvider) ClientOption {
+	return func(c *Client) {
+		c.zoomTokens = provider
+	}
+}
+
+// withZoomTokens returns the request with the Zoom parameters of the token provider of the client,
+// if it has one and the meeting is on Zoom. The request of the caller is not modified.
+func (c *Client) withZoomTokens(ctx context.Context, request *CreateBotRequest) (*CreateBotRequest, error) {
+	if c.zoomTokens == nil || ParseMeetingURL(request.MeetingURL).Platform != string(PlatformZoom) {
+		return request, nil
+	}
+
+	tokens, err := c.zoomTokens.ZoomTokens(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Zoom tokens: %w", err)
+	}
+	if tokens == nil {
+		return request, nil
+	}
+
+	zoom := Zoom{}
+	if request.Zoom != nil {
+		zoom = *request.Zoom
+	}
+	if tokens.JoinTokenURL != "" {
+		zoom.JoinTokenURL = tokens.JoinTokenURL
+	}
+	if tokens.ZakURL != "" {
+		zoom.ZakURL = tokens.ZakURL
+	}
+	if tokens.UserEmail != "" {
+		zoom.UserEmail = tokens.UserEmail
+	}
+
+	r := *request
+	r.Zoom = &zoom
+	return &r, nil
+}
@@ -0,0 +1,103 @@
+package recallaigo_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	recallaigo "github.com/harrison-peng/recallai-go"
+	"github.com/harrison-peng/recallai-go/testutil"
+)
+
+func TestWithZoomTokens(t *testing.T) {
+	errProvider := errors.New("provider failed")
+
+	tests := []struct {
+		name       string
+		meetingURL string
+		zoom       *recallaigo.Zoom
+		tokens     *recallaigo.Zoom
+		err        error
+		wantCalled bool
+		wantZoom   *recallaigo.Zoom
+		wantErr    error
+	}{
+		{
+			name:       "sets the tokens of Zoom meetings",
+			meetingURL: "https://us02web.zoom.us/j/123?pwd=456",
+			tokens:     &recallaigo.Zoom{JoinTokenURL: "https://tokens.test/join?sig=1", ZakURL: "https://tokens.test/zak?sig=1"},
+			wantCalled: true,
+			wantZoom:   &recallaigo.Zoom{JoinTokenURL: "https://tokens.test/join?sig=1", ZakURL: "https://tokens.test/zak?sig=1"},
+		},
+		{
+			name:       "keeps the fields of the request left empty by the provider",
+			meetingURL: "https://zoom.us/j/123",
+			zoom:       &recallaigo.Zoom{JoinTokenURL: "https://static.test/join", UserEmail: "bot@example.com"},
+			tokens:     &recallaigo.Zoom{JoinTokenURL: "https://tokens.test/join?sig=2"},
+			wantCalled: true,
+			wantZoom:   &recallaigo.Zoom{JoinTokenURL: "https://tokens.test/join?sig=2", UserEmail: "bot@example.com"},
+		},
+		{
+			name:       "ignores other platforms",
+			meetingURL: "https://meet.google.com/abc-defg-hij",
+			tokens:     &recallaigo.Zoom{ZakURL: "https://tokens.test/zak"},
+		},
+		{
+			name:       "returns the error of the provider",
+			meetingURL: "https://zoom.us/j/123",
+			err:        errProvider,
+			wantCalled: true,
+			wantErr:    errProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent *recallaigo.CreateBotRequest
+			router := testutil.NewRouter()
+			router.Handle("POST /api/v1/bot", func(req *http.Request) *http.Response {
+				sent = &recallaigo.CreateBotRequest{}
+				if err := json.NewDecoder(req.Body).Decode(sent); err != nil {
+					t.Errorf("failed to decode request: %v", err)
+				}
+				return testutil.NewFileResponse(t, "test_data/create_bot.json", http.StatusCreated)
+			})
+
+			called := false
+			provider := recallaigo.ZoomTokenFunc(func(ctx context.Context, request *recallaigo.CreateBotRequest) (*recallaigo.Zoom, error) {
+				called = true
+				return tt.tokens, tt.err
+			})
+			client := recallaigo.NewClient("some_token",
+				recallaigo.WithHTTPClient(router.Client()),
+				recallaigo.WithZoomTokens(provider),
+			)
+
+			request := &recallaigo.CreateBotRequest{MeetingURL: tt.meetingURL, BotName: "Test Bot", Zoom: tt.zoom}
+			_, err := client.Bot.CreateBot(context.Background(), request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateBot() error = %v, want %v", err, tt.wantErr)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("provider called = %v, want %v", called, tt.wantCalled)
+			}
+			if request.Zoom != tt.zoom {
+				t.Errorf("CreateBot() modified the Zoom parameters of the request")
+			}
+			if tt.wantErr != nil {
+				if sent != nil {
+					t.Errorf("CreateBot() sent a request despite the error")
+				}
+				return
+			}
+
+			switch {
+			case sent.Zoom == nil && tt.wantZoom == nil:
+			case sent.Zoom == nil || tt.wantZoom == nil || *sent.Zoom != *tt.wantZoom:
+				t.Errorf("sent Zoom = %+v, want %+v", sent.Zoom, tt.wantZoom)
+			}
+		})
+	}
+}